@@ -5,5 +5,9 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder     = closeVCRRecorder
+	RedactVCRBody        = redactVCRBody
+	RedactVCRInteraction = redactVCRInteraction
+	VCRBodiesMatch       = vcrBodiesMatch
+	VCRValuesMatch       = vcrValuesMatch
 )
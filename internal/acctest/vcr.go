@@ -7,15 +7,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -183,6 +180,17 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}, recorder.AfterCaptureHook)
 
+		// Redact secrets from HTTP bodies.
+		// Captured interactions are used to build the responses seen during recording, so redact only when saving.
+		r.AddHook(func(i *cassette.Interaction) error {
+			var accountID string
+			if meta != nil {
+				accountID = meta.AccountID
+			}
+
+			return redactVCRInteraction(i, accountID)
+		}, recorder.BeforeSaveHook)
+
 		// Defines how VCR will match requests to responses.
 		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
 			// Default matcher compares method and URL only.
@@ -209,50 +217,16 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 				return true
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
+			// Bodies might be the same, but reordered or with volatile values.
+			match, err := vcrBodiesMatch(r.Header.Get("Content-Type"), body, i.Body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to compare request body with cassette", map[string]interface{}{
+					"error": err,
+				})
+				return false
 			}
 
-			return false
+			return match
 		})
 
 		// Use the wrapped HTTP Client for AWS APIs.
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// vcrVolatileParameters contains the names of request parameters whose values differ between recording and replay,
+// e.g. idempotency tokens and timestamps generated at request time.
+var vcrVolatileParameters = struct {
+	lock  sync.Mutex
+	names map[string]struct{}
+}{
+	names: map[string]struct{}{
+		"CallerReference":    {},
+		"ClientRequestToken": {},
+		"ClientToken":        {},
+		"IdempotencyToken":   {},
+		"Timestamp":          {},
+	},
+}
+
+// RegisterVCRVolatileParameters adds the names of request parameters that are ignored when matching requests to VCR cassette interactions.
+// A name matches a JSON object key, an XML element's local name or the last `.`-separated segment of an AWS Query protocol parameter name,
+// e.g. `ClientToken` matches `LaunchTemplateData.ClientToken`.
+func RegisterVCRVolatileParameters(names ...string) {
+	vcrVolatileParameters.lock.Lock()
+	defer vcrVolatileParameters.lock.Unlock()
+
+	for _, name := range names {
+		vcrVolatileParameters.names[name] = struct{}{}
+	}
+}
+
+func isVCRVolatileParameter(name string) bool {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	vcrVolatileParameters.lock.Lock()
+	defer vcrVolatileParameters.lock.Unlock()
+
+	_, ok := vcrVolatileParameters.names[name]
+
+	return ok
+}
+
+// vcrBodiesMatch returns whether a request body matches a cassette request body of the specified content type.
+// Bodies are compared structurally, ignoring volatile parameters. Redacted cassette values match any request value.
+func vcrBodiesMatch(contentType, request, cassette string) (bool, error) {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	switch strings.TrimSpace(mediaType) {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered.
+		var requestJSON, cassetteJSON any
+
+		if err := json.Unmarshal([]byte(request), &requestJSON); err != nil {
+			return false, fmt.Errorf("unmarshaling request JSON: %w", err)
+		}
+
+		if err := json.Unmarshal([]byte(cassette), &cassetteJSON); err != nil {
+			return false, fmt.Errorf("unmarshaling cassette JSON: %w", err)
+		}
+
+		return vcrValuesMatch(requestJSON, cassetteJSON), nil
+
+	case "application/x-www-form-urlencoded":
+		// AWS Query and EC2 protocol parameters might be the same, but reordered.
+		return vcrFormBodiesMatch(request, cassette)
+
+	case "application/xml", "text/xml":
+		// REST-XML elements are serialized in a fixed order but may contain volatile values.
+		return vcrXMLBodiesMatch(request, cassette)
+	}
+
+	return false, nil
+}
+
+// vcrValuesMatch returns whether a value decoded from a request body matches the corresponding value decoded from a cassette.
+// Volatile object keys are ignored and redacted cassette values match any request value.
+func vcrValuesMatch(request, cassette any) bool {
+	if cassette == vcrRedactedPlaceholder {
+		return true
+	}
+
+	switch cassette := cassette.(type) {
+	case map[string]any:
+		request, ok := request.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, value := range cassette {
+			if isVCRVolatileParameter(key) {
+				continue
+			}
+
+			v, ok := request[key]
+			if !ok || !vcrValuesMatch(v, value) {
+				return false
+			}
+		}
+
+		for key := range request {
+			if _, ok := cassette[key]; !ok && !isVCRVolatileParameter(key) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		request, ok := request.([]any)
+		if !ok || len(request) != len(cassette) {
+			return false
+		}
+
+		for i, value := range cassette {
+			if !vcrValuesMatch(request[i], value) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(request, cassette)
+}
+
+// vcrFormBodiesMatch returns whether two AWS Query protocol request bodies contain the same parameters, in any order.
+func vcrFormBodiesMatch(request, cassette string) (bool, error) {
+	requestValues, err := url.ParseQuery(request)
+	if err != nil {
+		return false, fmt.Errorf("parsing request form body: %w", err)
+	}
+
+	cassetteValues, err := url.ParseQuery(cassette)
+	if err != nil {
+		return false, fmt.Errorf("parsing cassette form body: %w", err)
+	}
+
+	for _, values := range []url.Values{requestValues, cassetteValues} {
+		for name := range values {
+			if isVCRVolatileParameter(name) {
+				values.Del(name)
+			}
+		}
+	}
+
+	if len(requestValues) != len(cassetteValues) {
+		return false, nil
+	}
+
+	for name, cassetteValue := range cassetteValues {
+		requestValue, ok := requestValues[name]
+		if !ok || len(requestValue) != len(cassetteValue) {
+			return false, nil
+		}
+
+		for i, v := range cassetteValue {
+			if v != vcrRedactedPlaceholder && v != requestValue[i] {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// vcrXMLElement is an XML element decoded for matching.
+type vcrXMLElement struct {
+	name       xml.Name
+	attributes map[xml.Name]string
+	text       string
+	children   []*vcrXMLElement
+}
+
+// vcrXMLBodiesMatch returns whether two XML request bodies contain the same elements, attributes and text.
+// Whitespace surrounding text is ignored.
+func vcrXMLBodiesMatch(request, cassette string) (bool, error) {
+	requestXML, err := decodeVCRXML(request)
+	if err != nil {
+		return false, fmt.Errorf("decoding request XML: %w", err)
+	}
+
+	cassetteXML, err := decodeVCRXML(cassette)
+	if err != nil {
+		return false, fmt.Errorf("decoding cassette XML: %w", err)
+	}
+
+	return vcrXMLElementsMatch(requestXML, cassetteXML), nil
+}
+
+// decodeVCRXML decodes the specified XML document, returning its root element.
+func decodeVCRXML(body string) (*vcrXMLElement, error) {
+	var root *vcrXMLElement
+	var stack []*vcrXMLElement
+	decoder := xml.NewDecoder(strings.NewReader(body))
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			e := &vcrXMLElement{
+				name:       token.Name,
+				attributes: make(map[xml.Name]string, len(token.Attr)),
+			}
+			for _, attr := range token.Attr {
+				e.attributes[attr.Name] = attr.Value
+			}
+
+			if n := len(stack); n > 0 {
+				stack[n-1].children = append(stack[n-1].children, e)
+			} else if root == nil {
+				root = e
+			}
+
+			stack = append(stack, e)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if n := len(stack); n > 0 {
+				stack[n-1].text += string(token)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("no root element")
+	}
+
+	return root, nil
+}
+
+func vcrXMLElementsMatch(request, cassette *vcrXMLElement) bool {
+	if request.name != cassette.name || !reflect.DeepEqual(request.attributes, cassette.attributes) {
+		return false
+	}
+
+	// Redacted cassette elements match any request content.
+	text := strings.TrimSpace(cassette.text)
+	if text == vcrRedactedPlaceholder && len(cassette.children) == 0 {
+		return true
+	}
+
+	if text != strings.TrimSpace(request.text) {
+		return false
+	}
+
+	requestChildren, cassetteChildren := vcrXMLNonVolatileChildren(request), vcrXMLNonVolatileChildren(cassette)
+
+	if len(requestChildren) != len(cassetteChildren) {
+		return false
+	}
+
+	for i, child := range cassetteChildren {
+		if !vcrXMLElementsMatch(requestChildren[i], child) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func vcrXMLNonVolatileChildren(e *vcrXMLElement) []*vcrXMLElement {
+	var children []*vcrXMLElement
+
+	for _, child := range e.children {
+		if !isVCRVolatileParameter(child.name.Local) {
+			children = append(children, child)
+		}
+	}
+
+	return children
+}
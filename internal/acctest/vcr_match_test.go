@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVCRBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		contentType string
+		request     string
+		cassette    string
+		want        bool
+		wantErr     bool
+	}{
+		{
+			name:        "JSON reordered",
+			contentType: "application/x-amz-json-1.1",
+			request:     `{"Name":"example","Description":"test"}`,
+			cassette:    `{"Description":"test","Name":"example"}`,
+			want:        true,
+		},
+		{
+			name:        "invalid JSON",
+			contentType: "application/json",
+			request:     `{"Name":`,
+			cassette:    `{"Name":"example"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "form reordered",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			request:     "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			cassette:    "Version=2016-11-15&Action=CreateVpc&CidrBlock=10.0.0.0%2F16",
+			want:        true,
+		},
+		{
+			name:        "form not equal",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			request:     "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			cassette:    "Action=CreateVpc&CidrBlock=10.1.0.0%2F16&Version=2016-11-15",
+			want:        false,
+		},
+		{
+			name:        "form extra parameter",
+			contentType: "application/x-www-form-urlencoded",
+			request:     "Action=CreateVpc&AmazonProvidedIpv6CidrBlock=true&Version=2016-11-15",
+			cassette:    "Action=CreateVpc&Version=2016-11-15",
+			want:        false,
+		},
+		{
+			name:        "form volatile",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			request:     "Action=RunInstances&ClientToken=terraform-1&LaunchTemplate.ClientToken=a&Version=2016-11-15",
+			cassette:    "Action=RunInstances&ClientToken=terraform-2&Version=2016-11-15",
+			want:        true,
+		},
+		{
+			name:        "form redacted",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			request:     "Action=CreateLoginProfile&Password=s3cr3t&UserName=example&Version=2010-05-08",
+			cassette:    "Action=CreateLoginProfile&Password=REDACTED&UserName=example&Version=2010-05-08",
+			want:        true,
+		},
+		{
+			name:        "form list members",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			request:     "Action=DescribeVpcs&VpcId.1=vpc-1&VpcId.2=vpc-2&Version=2016-11-15",
+			cassette:    "Action=DescribeVpcs&VpcId.1=vpc-2&VpcId.2=vpc-1&Version=2016-11-15",
+			want:        false,
+		},
+		{
+			name:        "XML equal",
+			contentType: "application/xml",
+			request:     `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.com</Name></CreateHostedZoneRequest>`,
+			cassette: `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Name>example.com</Name>
+</CreateHostedZoneRequest>`,
+			want: true,
+		},
+		{
+			name:        "XML not equal",
+			contentType: "application/xml",
+			request:     `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.com</Name></CreateHostedZoneRequest>`,
+			cassette:    `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.org</Name></CreateHostedZoneRequest>`,
+			want:        false,
+		},
+		{
+			name:        "XML reordered",
+			contentType: "application/xml",
+			request:     `<Tagging><TagSet><Tag><Key>a</Key><Value>1</Value></Tag></TagSet></Tagging>`,
+			cassette:    `<Tagging><TagSet><Tag><Value>1</Value><Key>a</Key></Tag></TagSet></Tagging>`,
+			want:        false,
+		},
+		{
+			name:        "XML volatile",
+			contentType: "application/xml",
+			request:     `<CreateHostedZoneRequest><CallerReference>terraform-1</CallerReference><Name>example.com</Name></CreateHostedZoneRequest>`,
+			cassette:    `<CreateHostedZoneRequest><CallerReference>terraform-2</CallerReference><Name>example.com</Name></CreateHostedZoneRequest>`,
+			want:        true,
+		},
+		{
+			name:        "XML redacted",
+			contentType: "application/xml",
+			request:     `<Config><Secret><Value>s3cr3t</Value></Secret></Config>`,
+			cassette:    `<Config><Secret>REDACTED</Secret></Config>`,
+			want:        true,
+		},
+		{
+			name:        "invalid XML",
+			contentType: "application/xml",
+			request:     `<Config>`,
+			cassette:    `<Config></Config>`,
+			wantErr:     true,
+		},
+		{
+			name:        "other content type",
+			contentType: "application/octet-stream",
+			request:     "a",
+			cassette:    "b",
+			want:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := acctest.VCRBodiesMatch(testCase.contentType, testCase.request, testCase.cassette)
+
+			if gotErr := err != nil; gotErr != testCase.wantErr {
+				t.Fatalf("err = %v, want error %t", err, testCase.wantErr)
+			}
+
+			if got != testCase.want {
+				t.Errorf("VCRBodiesMatch = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestVCRValuesMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		request  string
+		cassette string
+		want     bool
+	}{
+		{
+			name:     "equal",
+			request:  `{"Name":"example","Tags":[{"Key":"k","Value":"v"}]}`,
+			cassette: `{"Tags":[{"Key":"k","Value":"v"}],"Name":"example"}`,
+			want:     true,
+		},
+		{
+			name:     "not equal",
+			request:  `{"Name":"example1"}`,
+			cassette: `{"Name":"example2"}`,
+			want:     false,
+		},
+		{
+			name:     "redacted",
+			request:  `{"Name":"example","SecretString":"s3cr3t"}`,
+			cassette: `{"Name":"example","SecretString":"REDACTED"}`,
+			want:     true,
+		},
+		{
+			name:     "redacted in array",
+			request:  `{"Values":["a","b"]}`,
+			cassette: `{"Values":["a","REDACTED"]}`,
+			want:     true,
+		},
+		{
+			name:     "redacted key missing",
+			request:  `{"Name":"example"}`,
+			cassette: `{"Name":"example","SecretString":"REDACTED"}`,
+			want:     false,
+		},
+		{
+			name:     "volatile",
+			request:  `{"ClientToken":"terraform-20240101000000000000000001","Name":"example"}`,
+			cassette: `{"ClientToken":"terraform-20240101000000000000000002","Name":"example"}`,
+			want:     true,
+		},
+		{
+			name:     "volatile missing",
+			request:  `{"ClientToken":"terraform-20240101000000000000000001","Name":"example"}`,
+			cassette: `{"Name":"example"}`,
+			want:     true,
+		},
+		{
+			name:     "extra request key",
+			request:  `{"Name":"example","SecretString":"s3cr3t"}`,
+			cassette: `{"Name":"example"}`,
+			want:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var request, cassette any
+			if err := json.Unmarshal([]byte(testCase.request), &request); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(testCase.cassette), &cassette); err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := acctest.VCRValuesMatch(request, cassette), testCase.want; got != want {
+				t.Errorf("VCRValuesMatch = %t, want %t", got, want)
+			}
+		})
+	}
+}
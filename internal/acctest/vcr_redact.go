@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrRedactedPlaceholder replaces redacted values in VCR cassettes.
+	vcrRedactedPlaceholder = "REDACTED"
+	// vcrAccountID replaces the recording AWS account ID in VCR cassettes.
+	vcrAccountID = "123456789012"
+)
+
+// VCRRedactionRules contains the paths of values to redact from a service's HTTP request and response bodies before a VCR cassette is saved.
+// A path is a sequence of segments separated by `/`. For JSON bodies each segment is an object key or array index, and
+// for XML bodies each segment is an element's local name, starting at the root element. A `*` segment matches any key, index or name.
+type VCRRedactionRules struct {
+	Request  []string
+	Response []string
+}
+
+// vcrRedactions contains the redaction rules for each service, keyed by the service's endpoint prefix, e.g. `kms`.
+var vcrRedactions = struct {
+	lock  sync.Mutex
+	rules map[string]VCRRedactionRules
+}{
+	rules: map[string]VCRRedactionRules{
+		"iam": {
+			Response: []string{
+				"CreateAccessKeyResponse/CreateAccessKeyResult/AccessKey/SecretAccessKey",
+				"CreateServiceSpecificCredentialResponse/CreateServiceSpecificCredentialResult/ServiceSpecificCredential/ServicePassword",
+				"ResetServiceSpecificCredentialResponse/ResetServiceSpecificCredentialResult/ServiceSpecificCredential/ServicePassword",
+			},
+		},
+		"kms": {
+			Request: []string{
+				"Plaintext",
+			},
+			Response: []string{
+				"Plaintext",
+			},
+		},
+		"secretsmanager": {
+			Request: []string{
+				"SecretBinary",
+				"SecretString",
+			},
+			Response: []string{
+				"SecretBinary",
+				"SecretString",
+			},
+		},
+		"sts": {
+			Response: []string{
+				"*/*/Credentials/SecretAccessKey",
+				"*/*/Credentials/SessionToken",
+			},
+		},
+	},
+}
+
+// RegisterVCRRedactions adds rules for redacting secrets from the specified service's VCR cassettes.
+// The service is identified by its endpoint prefix, e.g. `secretsmanager`.
+func RegisterVCRRedactions(service string, rules VCRRedactionRules) {
+	vcrRedactions.lock.Lock()
+	defer vcrRedactions.lock.Unlock()
+
+	v := vcrRedactions.rules[service]
+	v.Request = append(v.Request, rules.Request...)
+	v.Response = append(v.Response, rules.Response...)
+	vcrRedactions.rules[service] = v
+}
+
+func vcrRedactionRulesFor(service string) VCRRedactionRules {
+	vcrRedactions.lock.Lock()
+	defer vcrRedactions.lock.Unlock()
+
+	return vcrRedactions.rules[service]
+}
+
+// vcrService returns the endpoint prefix of the service that the specified request URL addresses.
+func vcrService(requestURL string) string {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return ""
+	}
+
+	service, _, _ := strings.Cut(u.Hostname(), ".")
+
+	return strings.TrimSuffix(service, "-fips")
+}
+
+// redactVCRInteraction redacts secrets from the specified interaction's request and response bodies
+// and replaces the recording AWS account ID with a fixed value.
+// It must be run before the cassette is saved and not after capture, as captured interactions are used to build the responses seen during recording.
+func redactVCRInteraction(i *cassette.Interaction, accountID string) error {
+	rules := vcrRedactionRulesFor(vcrService(i.Request.URL))
+
+	var errs []error
+
+	if body, err := redactVCRBody(i.Request.Body, i.Request.Headers.Get("Content-Type"), rules.Request); err != nil {
+		errs = append(errs, err)
+	} else {
+		i.Request.Body = body
+	}
+
+	if body, err := redactVCRBody(i.Response.Body, i.Response.Headers.Get("Content-Type"), rules.Response); err != nil {
+		errs = append(errs, err)
+	} else {
+		i.Response.Body = body
+	}
+
+	if accountID != "" && accountID != vcrAccountID {
+		i.Request.URL = strings.ReplaceAll(i.Request.URL, accountID, vcrAccountID)
+		i.Request.Body = strings.ReplaceAll(i.Request.Body, accountID, vcrAccountID)
+		replaceHeaderValues(i.Request.Headers, accountID, vcrAccountID)
+		i.Response.Body = strings.ReplaceAll(i.Response.Body, accountID, vcrAccountID)
+		replaceHeaderValues(i.Response.Headers, accountID, vcrAccountID)
+	}
+
+	// Keep the recorded lengths consistent with the redacted bodies.
+	i.Request.ContentLength = int64(len(i.Request.Body))
+	if i.Response.ContentLength >= 0 {
+		i.Response.ContentLength = int64(len(i.Response.Body))
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(i.Response.Body)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func replaceHeaderValues(headers http.Header, from, to string) {
+	for _, values := range headers {
+		for i, v := range values {
+			values[i] = strings.ReplaceAll(v, from, to)
+		}
+	}
+}
+
+// redactVCRBody redacts the values at the specified paths in a JSON or XML body.
+// Bodies of any other content type are returned unchanged.
+func redactVCRBody(body, contentType string, paths []string) (string, error) {
+	if body == "" || len(paths) == 0 {
+		return body, nil
+	}
+
+	segments := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		segments = append(segments, strings.Split(path, "/"))
+	}
+
+	mediaType, _, _ := strings.Cut(contentType, ";")
+
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	switch strings.TrimSpace(mediaType) {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return redactVCRJSON(body, segments)
+	case "application/xml", "text/xml":
+		return redactVCRXML(body, segments)
+	}
+
+	return body, nil
+}
+
+func redactVCRJSON(body string, paths [][]string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return body, err
+	}
+
+	var redacted bool
+	for _, path := range paths {
+		var ok bool
+		if v, ok = redactVCRJSONValue(v, path); ok {
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return body, nil
+	}
+
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return body, err
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// redactVCRJSONValue returns the specified JSON value with the value at the specified path redacted,
+// and whether any value was redacted.
+func redactVCRJSONValue(v any, path []string) (any, bool) {
+	if len(path) == 0 {
+		if v == nil {
+			return v, false
+		}
+
+		return vcrRedactedPlaceholder, true
+	}
+
+	var redacted bool
+
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if path[0] == "*" || path[0] == key {
+				if value, ok := redactVCRJSONValue(value, path[1:]); ok {
+					v[key] = value
+					redacted = true
+				}
+			}
+		}
+	case []any:
+		for i, value := range v {
+			if path[0] == "*" || path[0] == strconv.Itoa(i) {
+				if value, ok := redactVCRJSONValue(value, path[1:]); ok {
+					v[i] = value
+					redacted = true
+				}
+			}
+		}
+	}
+
+	return v, redacted
+}
+
+func redactVCRXML(body string, paths [][]string) (string, error) {
+	type span struct {
+		start, end int64
+	}
+
+	var spans []span
+	var names []string
+	start, depth := int64(-1), 0
+	decoder := xml.NewDecoder(strings.NewReader(body))
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return body, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			names = append(names, token.Name.Local)
+
+			if start < 0 && slices.ContainsFunc(paths, func(path []string) bool {
+				return vcrPathMatch(path, names)
+			}) {
+				start, depth = decoder.InputOffset(), len(names)
+			}
+		case xml.EndElement:
+			if start >= 0 && len(names) == depth {
+				// Empty elements have nothing to redact.
+				if offset > start {
+					spans = append(spans, span{start, offset})
+				}
+				start = -1
+			}
+
+			names = names[:len(names)-1]
+		}
+	}
+
+	if len(spans) == 0 {
+		return body, nil
+	}
+
+	var b strings.Builder
+	var previous int64
+	for _, span := range spans {
+		b.WriteString(body[previous:span.start])
+		b.WriteString(vcrRedactedPlaceholder)
+		previous = span.end
+	}
+	b.WriteString(body[previous:])
+
+	return b.String(), nil
+}
+
+func vcrPathMatch(path, names []string) bool {
+	if len(path) != len(names) {
+		return false
+	}
+
+	for i, segment := range path {
+		if segment != "*" && segment != names[i] {
+			return false
+		}
+	}
+
+	return true
+}
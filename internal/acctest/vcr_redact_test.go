@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestRedactVCRBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		body        string
+		contentType string
+		paths       []string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "no paths",
+			body:        `{"SecretString":"s3cr3t"}`,
+			contentType: "application/x-amz-json-1.1",
+			want:        `{"SecretString":"s3cr3t"}`,
+		},
+		{
+			name:        "JSON top-level key",
+			body:        `{"Name":"example","SecretString":"s3cr3t","VersionStages":["AWSCURRENT"]}`,
+			contentType: "application/x-amz-json-1.1",
+			paths:       []string{"SecretString"},
+			want:        `{"Name":"example","SecretString":"REDACTED","VersionStages":["AWSCURRENT"]}`,
+		},
+		{
+			name:        "JSON wildcard",
+			body:        `{"Parameters":[{"Name":"a","Value":"1"},{"Name":"b","Value":"2"}]}`,
+			contentType: "application/x-amz-json-1.1",
+			paths:       []string{"Parameters/*/Value"},
+			want:        `{"Parameters":[{"Name":"a","Value":"REDACTED"},{"Name":"b","Value":"REDACTED"}]}`,
+		},
+		{
+			name:        "JSON path not found",
+			body:        `{"Name": "example"}`,
+			contentType: "application/json",
+			paths:       []string{"SecretString"},
+			want:        `{"Name": "example"}`,
+		},
+		{
+			name:        "JSON numbers preserved",
+			body:        `{"Plaintext":"cGxhaW50ZXh0","Size":12345678901234567890}`,
+			contentType: "application/x-amz-json-1.1",
+			paths:       []string{"Plaintext"},
+			want:        `{"Plaintext":"REDACTED","Size":12345678901234567890}`,
+		},
+		{
+			name:        "invalid JSON",
+			body:        `{"Plaintext":`,
+			contentType: "application/x-amz-json-1.1",
+			paths:       []string{"Plaintext"},
+			want:        `{"Plaintext":`,
+			wantErr:     true,
+		},
+		{
+			name: "XML",
+			body: `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>wJalrXUtnFEMI/K7MDENG</SecretAccessKey>
+      <SessionToken>FwoGZXIvYXdzEBY</SessionToken>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`,
+			contentType: "text/xml",
+			paths:       []string{"*/*/Credentials/SecretAccessKey", "*/*/Credentials/SessionToken"},
+			want: `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>REDACTED</SecretAccessKey>
+      <SessionToken>REDACTED</SessionToken>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`,
+		},
+		{
+			name:        "XML empty element",
+			body:        `<A><B/><C></C></A>`,
+			contentType: "application/xml",
+			paths:       []string{"A/B", "A/C"},
+			want:        `<A><B/><C></C></A>`,
+		},
+		{
+			name:        "other content type",
+			body:        `Action=CreateLoginProfile&Password=s3cr3t`,
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			paths:       []string{"Password"},
+			want:        `Action=CreateLoginProfile&Password=s3cr3t`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := acctest.RedactVCRBody(testCase.body, testCase.contentType, testCase.paths)
+
+			if gotErr := err != nil; gotErr != testCase.wantErr {
+				t.Fatalf("err = %v, want error %t", err, testCase.wantErr)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRedactVCRInteraction(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body: `{"Name":"example","SecretString":"s3cr3t"}`,
+			Headers: http.Header{
+				"Content-Type": {"application/x-amz-json-1.1"},
+			},
+			URL: "https://secretsmanager.us-west-2.amazonaws.com/", // lintignore:AWSAT003
+		},
+		Response: cassette.Response{
+			Body: `{"ARN":"arn:aws:secretsmanager:us-west-2:999999999999:secret:example","Name":"example"}`, // lintignore:AWSAT003,AWSAT005
+			Headers: http.Header{
+				"Content-Length": {"85"},
+				"Content-Type":   {"application/x-amz-json-1.1"},
+			},
+			ContentLength: 85,
+		},
+	}
+
+	if err := acctest.RedactVCRInteraction(i, "999999999999"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := i.Request.Body, `{"Name":"example","SecretString":"REDACTED"}`; got != want {
+		t.Errorf("request body: got %s, want %s", got, want)
+	}
+
+	want := `{"ARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:example","Name":"example"}` // lintignore:AWSAT003,AWSAT005
+	if got := i.Response.Body; got != want {
+		t.Errorf("response body: got %s, want %s", got, want)
+	}
+
+	if got, want := i.Response.ContentLength, int64(len(want)); got != want {
+		t.Errorf("response content length: got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// assumeRoleChain assumes each of the specified IAM Roles in order, starting from the credentials in cfg.
+// The credentials of each assumed role are used to assume the next role in the chain.
+// The credentials provider for the last role in the chain is returned.
+func assumeRoleChain(ctx context.Context, cfg aws_sdkv2.Config, stsEndpoint, stsRegion string, assumeRoles []awsbase.AssumeRole) (aws_sdkv2.CredentialsProvider, error) {
+	credentialsProvider := cfg.Credentials
+
+	for i, assumeRole := range assumeRoles {
+		cfg := cfg.Copy()
+		cfg.Credentials = credentialsProvider
+
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.index":    i + 1,
+			"tf_aws.assume_role.role_arn": assumeRole.RoleARN,
+		})
+
+		credentialsProvider = aws_sdkv2.NewCredentialsCache(stscreds_sdkv2.NewAssumeRoleProvider(client, assumeRole.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+			expandAssumeRoleOptions(assumeRole, o)
+		}))
+
+		// Retrieve credentials now so that any failure is reported against the correct role in the chain.
+		if _, err := credentialsProvider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s) (assume_role[%d]): %w", assumeRole.RoleARN, i+1, err)
+		}
+	}
+
+	return credentialsProvider, nil
+}
+
+func expandAssumeRoleOptions(assumeRole awsbase.AssumeRole, o *stscreds_sdkv2.AssumeRoleOptions) {
+	if v := assumeRole.Duration; v != 0 {
+		o.Duration = v
+	}
+
+	if v := assumeRole.ExternalID; v != "" {
+		o.ExternalID = aws_sdkv2.String(v)
+	}
+
+	if v := assumeRole.Policy; v != "" {
+		o.Policy = aws_sdkv2.String(v)
+	}
+
+	for _, v := range assumeRole.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if v := assumeRole.SessionName; v != "" {
+		o.RoleSessionName = v
+	}
+
+	if v := assumeRole.SourceIdentity; v != "" {
+		o.SourceIdentity = aws_sdkv2.String(v)
+	}
+
+	for k, v := range assumeRole.Tags {
+		o.Tags = append(o.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	o.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+}
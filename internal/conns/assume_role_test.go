@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	mockStsChainedAssumeRoleAccessKey   = `ChainedAssumeRoleAccessKey`
+	mockStsChainedAssumeRoleArn         = `arn:aws:iam::666666666666:role/ChainedAssumeRole`
+	mockStsChainedAssumeRoleExternalID  = `ChainedAssumeRoleExternalId`
+	mockStsChainedAssumeRoleSessionName = `ChainedAssumeRoleSessionName`
+)
+
+func TestAssumeRoleChain(t *testing.T) { //nolint:paralleltest
+	testcases := map[string]struct {
+		config            map[string]any
+		endpoints         []*servicemocks.MockEndpoint
+		expectError       bool
+		expectedAccessKey string
+	}{
+		"single role": {
+			config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+			},
+			endpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			expectedAccessKey: servicemocks.MockStsAssumeRoleAccessKey,
+		},
+
+		"chained roles": {
+			config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+					map[string]any{
+						"role_arn":     mockStsChainedAssumeRoleArn,
+						"session_name": mockStsChainedAssumeRoleSessionName,
+						"external_id":  mockStsChainedAssumeRoleExternalID,
+						"duration":     "1h",
+					},
+				},
+			},
+			endpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				mockStsChainedAssumeRoleValidEndpoint(map[string]string{
+					"DurationSeconds": "3600",
+					"ExternalId":      mockStsChainedAssumeRoleExternalID,
+				}),
+			},
+			expectedAccessKey: mockStsChainedAssumeRoleAccessKey,
+		},
+
+		"chained role cannot be assumed": {
+			config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+					map[string]any{
+						"role_arn":     mockStsChainedAssumeRoleArn,
+						"session_name": mockStsChainedAssumeRoleSessionName,
+					},
+				},
+			},
+			endpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testcases { //nolint:paralleltest
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			ts := servicemocks.MockAwsApiServer("STS", tc.endpoints)
+			t.Cleanup(ts.Close)
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2", // lintignore:AWSAT003
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"endpoints": []any{
+					map[string]any{
+						"sts": ts.URL,
+					},
+				},
+			}
+
+			maps.Copy(config, tc.config)
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if tc.expectError {
+				if !diags.HasError() {
+					t.Fatalf("expected error, got none: %s", sdkdiag.DiagnosticsString(diags))
+				}
+				return
+			}
+
+			expectedDiags := diag.Diagnostics{
+				errs.NewWarningDiagnostic(
+					"AWS account ID not found for provider",
+					"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications.",
+				),
+			}
+
+			if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			credentials, err := meta.CredentialsProvider(ctx).Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error retrieving credentials: %s", err)
+			}
+
+			if a, e := credentials.AccessKeyID, tc.expectedAccessKey; a != e {
+				t.Errorf("expected access key %q, got %q", e, a)
+			}
+		})
+	}
+}
+
+// mockStsChainedAssumeRoleValidEndpoint returns a valid STS AssumeRole response for the second role in a chain.
+// The response credentials differ from those returned for the first role so that the end of the chain can be identified.
+func mockStsChainedAssumeRoleValidEndpoint(options map[string]string) *servicemocks.MockEndpoint {
+	options = maps.Clone(options)
+	options["RoleArn"] = mockStsChainedAssumeRoleArn
+	options["RoleSessionName"] = mockStsChainedAssumeRoleSessionName
+
+	endpoint := servicemocks.MockStsAssumeRoleValidEndpointWithOptions(options)
+	endpoint.Response = &servicemocks.MockResponse{
+		Body:        strings.ReplaceAll(servicemocks.MockStsAssumeRoleValidResponseBody, servicemocks.MockStsAssumeRoleAccessKey, mockStsChainedAssumeRoleAccessKey),
+		ContentType: "text/xml",
+		StatusCode:  http.StatusOK,
+	}
+
+	return endpoint
+}
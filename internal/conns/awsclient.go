@@ -26,6 +26,8 @@ import (
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -37,15 +39,22 @@ type AWSClient struct {
 	Partition         string
 	Region            string
 	ServicePackages   map[string]ServicePackage
+	TagPolicyConfig   *tftags.PolicyConfig
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	defaultTimeouts           map[string]Timeouts // From provider configuration.
 	dnsSuffix                 string
+	endpointURL               string            // From provider configuration.
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	readCache                 *readCache                  // From provider configuration.
+	resourceTypeSemaphores    map[string]tfsync.Semaphore // From provider configuration.
+	retryEngine               *tfretry.Engine
+	servicePackageSemaphores  map[string]tfsync.Semaphore // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -69,7 +78,7 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) DynamoDBConnForRegion(ctx context.Context, region string) *dynamodb_sdkv1.DynamoDB {
-	if region == c.Region {
+	if region == c.ResourceRegion(ctx) {
 		return c.DynamoDBConn(ctx)
 	}
 	return dynamodb_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -79,7 +88,7 @@ func (c *AWSClient) DynamoDBConnForRegion(ctx context.Context, region string) *d
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directoryservice_sdkv1.DirectoryService {
-	if region == c.Region {
+	if region == c.ResourceRegion(ctx) {
 		return c.DSConn(ctx)
 	}
 	return directoryservice_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -89,7 +98,7 @@ func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directo
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sdkv1.EFS {
-	if region == c.Region {
+	if region == c.ResourceRegion(ctx) {
 		return c.EFSConn(ctx)
 	}
 	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -99,7 +108,7 @@ func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sd
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) KMSConnForRegion(ctx context.Context, region string) *kms_sdkv1.KMS {
-	if region == c.Region {
+	if region == c.ResourceRegion(ctx) {
 		return c.KMSConn(ctx)
 	}
 	return kms_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -109,7 +118,7 @@ func (c *AWSClient) KMSConnForRegion(ctx context.Context, region string) *kms_sd
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *opsworks_sdkv1.OpsWorks {
-	if region == c.Region {
+	if region == c.ResourceRegion(ctx) {
 		return c.OpsWorksConn(ctx)
 	}
 	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -126,19 +135,29 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.ResourceRegion(ctx), c.DNSSuffix(ctx))
 }
 
 // RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sdkv1.RDS {
-	if region == c.Region {
+	if region == c.ResourceRegion(ctx) {
 		return c.RDSConn(ctx)
 	}
 	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// ResourceRegion returns the AWS Region that the resource or data source being operated on is in.
+// This is the resource's `region` argument if set, otherwise the provider's configured Region.
+func (c *AWSClient) ResourceRegion(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.Region != "" {
+		return inContext.Region
+	}
+
+	return c.Region
+}
+
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
@@ -148,6 +167,11 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
+	// Only the S3 Express client for the provider's configured Region is cached.
+	if c.ResourceRegion(ctx) != c.Region {
+		return s3Client
+	}
+
 	if c.s3ExpressClient == nil {
 		if s3Client.Options().Region == names.GlobalRegionID {
 			c.s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
@@ -179,6 +203,12 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// RetryEngine returns the retry engine shared by all resources and data sources using this client.
+// Returns nil if the provider's retry budget is not enabled.
+func (c *AWSClient) RetryEngine(context.Context) *tfretry.Engine {
+	return c.retryEngine
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -252,8 +282,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.ResourceRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -262,8 +292,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.ResourceRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -286,6 +316,7 @@ func convertIPToDashIP(ip string) string {
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
+// If the Context carries a per-resource AWS Region override the parameters are for that Region.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
 		"aws_sdkv2_config": c.awsConfig,
@@ -293,6 +324,12 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if region := c.ResourceRegion(ctx); region != c.Region {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -309,12 +346,38 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// apiClientCacheKey returns the key under which the default API client for the specified service is cached.
+// Clients for a per-resource AWS Region override are cached separately from the provider's configured Region's.
+func (c *AWSClient) apiClientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.ResourceRegion(ctx); region != c.Region {
+		return servicePackageName + "@" + region
+	}
+
+	return servicePackageName
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
 		return endpoint
 	}
 
+	// A provider-level endpoint URL applies to all services, for SDK v1 and v2 packages alike.
+	// Per-service environment variables and shared config file settings still take precedence.
+	if c.endpointURL != "" {
+		if svc := os.Getenv(names.AwsServiceEnvVar(servicePackageName)); svc != "" {
+			return svc
+		}
+
+		if c.awsConfig != nil {
+			if endpoint, found, err := resolveServiceBaseEndpoint(ctx, names.SdkId(servicePackageName), c.awsConfig.ConfigSources); found && err == nil {
+				return endpoint
+			}
+		}
+
+		return c.endpointURL
+	}
+
 	// Only continue if there is an SDK v1 package. SDK v2 supports envvars and config file
 	if names.ClientSDKV1(servicePackageName) {
 		endpoint = aws_sdkv2.ToString(c.awsConfig.BaseEndpoint)
@@ -367,12 +430,13 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
+	key := c.apiClientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -416,7 +480,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
@@ -427,12 +491,13 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
+	key := c.apiClientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -467,7 +532,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -6,6 +6,9 @@ package conns
 import (
 	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +184,123 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientResourceRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	awsClient := &AWSClient{
+		dnsSuffix: "amazonaws.com",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name             string
+		Context          context.Context
+		ExpectedRegion   string
+		ExpectedHostname string
+	}{
+		{
+			Name:             "no resource context",
+			Context:          context.TODO(),
+			ExpectedRegion:   "us-west-2",                    //lintignore:AWSAT003
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:             "no override",
+			Context:          NewResourceContext(context.TODO(), "ec2", "VPC"),
+			ExpectedRegion:   "us-west-2",                    //lintignore:AWSAT003
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:             "override",
+			Context:          NewRegionContext(NewResourceContext(context.TODO(), "ec2", "VPC"), "eu-west-1"), //lintignore:AWSAT003
+			ExpectedRegion:   "eu-west-1",                                                                     //lintignore:AWSAT003
+			ExpectedHostname: "test.eu-west-1.amazonaws.com",                                                  //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := awsClient.ResourceRegion(testCase.Context), testCase.ExpectedRegion; got != want {
+				t.Errorf("region: got %s, expected %s", got, want)
+			}
+			if got, want := awsClient.RegionalHostname(testCase.Context, "test"), testCase.ExpectedHostname; got != want {
+				t.Errorf("hostname: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientResolveEndpoint(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	ctx := context.TODO()
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		EnvVars   map[string]string
+		Expected  string
+	}{
+		{
+			Name: "no configuration",
+			AWSClient: &AWSClient{
+				awsConfig: &aws_sdkv2.Config{},
+			},
+			Expected: "",
+		},
+		{
+			Name: "service endpoint",
+			AWSClient: &AWSClient{
+				awsConfig: &aws_sdkv2.Config{},
+				endpoints: map[string]string{
+					names.ACM: "https://acm.example.com",
+				},
+			},
+			Expected: "https://acm.example.com",
+		},
+		{
+			Name: "endpoint URL",
+			AWSClient: &AWSClient{
+				awsConfig:   &aws_sdkv2.Config{},
+				endpointURL: "http://localhost:4566",
+			},
+			Expected: "http://localhost:4566",
+		},
+		{
+			Name: "service endpoint and endpoint URL",
+			AWSClient: &AWSClient{
+				awsConfig:   &aws_sdkv2.Config{},
+				endpointURL: "http://localhost:4566",
+				endpoints: map[string]string{
+					names.ACM: "https://acm.example.com",
+				},
+			},
+			Expected: "https://acm.example.com",
+		},
+		{
+			Name: "service envvar and endpoint URL",
+			AWSClient: &AWSClient{
+				awsConfig:   &aws_sdkv2.Config{},
+				endpointURL: "http://localhost:4566",
+			},
+			EnvVars: map[string]string{
+				names.AwsServiceEnvVar(names.ACM): "https://acm-envvar.example.com",
+			},
+			Expected: "https://acm-envvar.example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(names.AwsServiceEnvVar(names.ACM), "")
+			for k, v := range testCase.EnvVars {
+				t.Setenv(k, v)
+			}
+
+			if got, want := testCase.AWSClient.resolveEndpoint(ctx, names.ACM), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
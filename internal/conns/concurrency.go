@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// newConcurrencyLimitSemaphores returns a semaphore for each configured concurrency limit.
+func newConcurrencyLimitSemaphores(limits map[string]int) map[string]tfsync.Semaphore {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	semaphores := make(map[string]tfsync.Semaphore, len(limits))
+	for k, v := range limits {
+		semaphores[k] = tfsync.NewSemaphore(v)
+	}
+
+	return semaphores
+}
+
+// concurrencyLimitSemaphores returns the semaphores that limit concurrent operations on the specified resource type.
+// The service package semaphore, if any, is always returned before the resource type semaphore so that semaphores
+// are acquired in a consistent order.
+func (c *AWSClient) concurrencyLimitSemaphores(servicePackageName, typeName string) []tfsync.Semaphore {
+	var semaphores []tfsync.Semaphore
+
+	if v, ok := c.servicePackageSemaphores[servicePackageName]; ok {
+		semaphores = append(semaphores, v)
+	}
+	if v, ok := c.resourceTypeSemaphores[typeName]; ok {
+		semaphores = append(semaphores, v)
+	}
+
+	return semaphores
+}
+
+// WaitConcurrencyLimits waits until an operation on the specified resource type is permitted by any
+// provider configured concurrency limits, or until the Context is done.
+// Every successful call must be paired with a call to NotifyConcurrencyLimits.
+func (c *AWSClient) WaitConcurrencyLimits(ctx context.Context, servicePackageName, typeName string) error {
+	semaphores := c.concurrencyLimitSemaphores(servicePackageName, typeName)
+
+	for i, semaphore := range semaphores {
+		if len(semaphore) == cap(semaphore) {
+			tflog.Debug(ctx, "Waiting for concurrency limit", map[string]any{
+				"tf_aws.concurrency_limit": cap(semaphore),
+			})
+		}
+
+		if err := semaphore.WaitContext(ctx); err != nil {
+			for _, semaphore := range tfslices.Reverse(semaphores[:i]) {
+				semaphore.Notify()
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NotifyConcurrencyLimits releases the concurrency limits acquired by WaitConcurrencyLimits.
+func (c *AWSClient) NotifyConcurrencyLimits(servicePackageName, typeName string) {
+	for _, semaphore := range tfslices.Reverse(c.concurrencyLimitSemaphores(servicePackageName, typeName)) {
+		semaphore.Notify()
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAWSClientConcurrencyLimits(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		resourceTypeSemaphores: newConcurrencyLimitSemaphores(map[string]int{
+			"aws_route53_record": 2,
+		}),
+		servicePackageSemaphores: newConcurrencyLimitSemaphores(map[string]int{
+			"route53": 1,
+		}),
+	}
+
+	if err := client.WaitConcurrencyLimits(ctx, "route53", "aws_route53_record"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The service package limit is exhausted.
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := client.WaitConcurrencyLimits(ctx2, "route53", "aws_route53_zone"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	// Resource types in other service packages are unaffected.
+	if err := client.WaitConcurrencyLimits(ctx, "iam", "aws_iam_role"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.NotifyConcurrencyLimits("iam", "aws_iam_role")
+
+	client.NotifyConcurrencyLimits("route53", "aws_route53_record")
+
+	if got, want := len(client.servicePackageSemaphores["route53"]), 0; got != want {
+		t.Errorf("service package semaphore length = %d, want %d", got, want)
+	}
+	if got, want := len(client.resourceTypeSemaphores["aws_route53_record"]), 0; got != want {
+		t.Errorf("resource type semaphore length = %d, want %d", got, want)
+	}
+
+	if err := client.WaitConcurrencyLimits(ctx, "route53", "aws_route53_zone"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.NotifyConcurrencyLimits("route53", "aws_route53_zone")
+}
@@ -21,47 +21,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftracing "github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      *string
-	HTTPSProxy                     *string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	NoProxy                        string
-	Profile                        string
-	Region                         string
-	RetryMode                      aws_sdkv2.RetryMode
-	S3UsePathStyle                 bool
-	S3USEast1RegionalEndpoint      string
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	TokenBucketRateLimiterCapacity int
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                       string
+	AllowedAccountIds               []string
+	AssumeRole                      []awsbase.AssumeRole
+	AssumeRoleWithWebIdentity       *awsbase.AssumeRoleWithWebIdentity
+	CustomCABundle                  string
+	DefaultTagsConfig               *tftags.DefaultConfig
+	DefaultTimeouts                 map[string]Timeouts
+	EC2MetadataServiceEnableState   imds_sdkv2.ClientEnableState
+	EC2MetadataServiceEndpoint      string
+	EC2MetadataServiceEndpointMode  string
+	EmulatorMode                    bool
+	EndpointURL                     string
+	Endpoints                       map[string]string
+	ForbiddenAccountIds             []string
+	HTTPProxy                       *string
+	HTTPSProxy                      *string
+	IgnoreTagsConfig                *tftags.IgnoreConfig
+	Insecure                        bool
+	MaxRetries                      int
+	NoProxy                         string
+	Profile                         string
+	ReadCache                       bool
+	Region                          string
+	ResourceTypeConcurrencyLimits   map[string]int
+	RetryBudget                     bool
+	RetryMode                       aws_sdkv2.RetryMode
+	S3UsePathStyle                  bool
+	S3USEast1RegionalEndpoint       string
+	SecretKey                       string
+	ServicePackageConcurrencyLimits map[string]int
+	SharedConfigFiles               []string
+	SharedCredentialsFiles          []string
+	SkipCredsValidation             bool
+	SkipRegionValidation            bool
+	SkipRequestingAccountId         bool
+	STSRegion                       string
+	SuppressDebugLog                bool
+	TagPolicyConfig                 *tftags.PolicyConfig
+	TerraformVersion                string
+	Token                           string
+	TokenBucketRateLimiterCapacity  int
+	UseDualStackEndpoint            bool
+	UseFIPSEndpoint                 bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -85,7 +95,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
 		ForbiddenAccountIds:            c.ForbiddenAccountIds,
-		IamEndpoint:                    c.Endpoints[names.IAM],
+		IamEndpoint:                    c.serviceEndpoint(names.IAM),
 		Insecure:                       c.Insecure,
 		HTTPClient:                     client.HTTPClient(ctx),
 		HTTPProxy:                      c.HTTPProxy,
@@ -101,8 +111,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                      c.SecretKey,
 		SkipCredsValidation:            c.SkipCredsValidation,
 		SkipRequestingAccountId:        c.SkipRequestingAccountId,
-		SsoEndpoint:                    c.Endpoints[names.SSO],
-		StsEndpoint:                    c.Endpoints[names.STS],
+		SsoEndpoint:                    c.serviceEndpoint(names.SSO),
+		StsEndpoint:                    c.serviceEndpoint(names.STS),
 		SuppressDebugLog:               c.SuppressDebugLog,
 		Token:                          c.Token,
 		TokenBucketRateLimiterCapacity: c.TokenBucketRateLimiterCapacity,
@@ -110,8 +120,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first role in the chain is assumed by aws-sdk-go-base using the base credentials.
+	// Any subsequent roles are assumed in sequence once the base configuration has been resolved.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = &c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -135,6 +147,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// Local AWS emulators generally don't implement the STS and IAM APIs used to determine the
+	// caller's account ID, or the EC2 metadata service.
+	if c.EmulatorMode {
+		awsbaseConfig.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -155,13 +175,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
-	if !c.SkipRegionValidation {
+	if len(c.AssumeRole) > 1 {
+		credentialsProvider, err := assumeRoleChain(ctx, cfg, c.serviceEndpoint(names.STS), c.STSRegion, c.AssumeRole[1:])
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "Cannot assume IAM Role: %s", err)
+		}
+		cfg.Credentials = credentialsProvider
+	}
+
+	if !c.SkipRegionValidation && !c.EmulatorMode {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 	c.Region = cfg.Region
 
+	// aws-sdk-go-base wraps each AWS SDK for Go v2 API call in a span. Record additional attributes on those spans.
+	cfg.APIOptions = append(cfg.APIOptions, tftracing.AddSDKv2Middlewares)
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -179,6 +210,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	tftracing.AddSDKv1Handlers(&session.Handlers)
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -189,7 +222,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
-	if accountID == "" {
+	if accountID == "" && !c.EmulatorMode {
 		diags = append(diags, errs.NewWarningDiagnostic(
 			"AWS account ID not found for provider",
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
@@ -207,10 +240,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTimeouts = c.DefaultTimeouts
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
+	if c.ReadCache {
+		client.readCache = newReadCache()
+	}
+	client.resourceTypeSemaphores = newConcurrencyLimitSemaphores(c.ResourceTypeConcurrencyLimits)
+	client.servicePackageSemaphores = newConcurrencyLimitSemaphores(c.ServicePackageConcurrencyLimits)
+	if c.RetryBudget {
+		client.retryEngine = tfretry.NewEngine()
+	}
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -218,6 +261,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
+	client.endpointURL = c.EndpointURL
 	client.endpoints = c.Endpoints
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
@@ -238,6 +282,16 @@ func baseSeverityToSdkSeverity(s basediag.Severity) diag.Severity {
 	}
 }
 
+// serviceEndpoint returns the endpoint configured for the specified service package,
+// falling back to the provider-level endpoint URL.
+func (c *Config) serviceEndpoint(servicePackageName string) string {
+	if v := c.Endpoints[servicePackageName]; v != "" {
+		return v
+	}
+
+	return c.EndpointURL
+}
+
 func NormalizeS3USEast1RegionalEndpoint(v string) string {
 	switch v := strings.ToLower(v); v {
 	case "legacy", "regional":
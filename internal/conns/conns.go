@@ -30,6 +30,7 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	Region             string // Per-resource AWS Region override, empty for the provider's configured Region
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
@@ -53,6 +54,17 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName st
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// NewRegionContext returns a copy of the Context with the specified per-resource AWS Region override.
+func NewRegionContext(ctx context.Context, region string) context.Context {
+	var v InContext
+	if inContext, ok := FromContext(ctx); ok {
+		v = *inContext
+	}
+	v.Region = region
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCache caches the results of AWS API read operations for the lifetime of a provider operation
+// (e.g. `terraform plan`), so that many resources sharing a parent object (e.g. the rules of a security group)
+// make a single API call for the parent rather than one call each.
+// Concurrent reads of the same key are coalesced into a single call.
+// Entries are grouped by service package so that any write operation in the service package invalidates them.
+type readCache struct {
+	entries map[string]map[string]*readCacheEntry // Service package name -> key -> entry.
+	lock    sync.Mutex
+}
+
+type readCacheEntry struct {
+	done  chan struct{} // Closed once the read has completed.
+	err   error
+	value any
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries: make(map[string]map[string]*readCacheEntry),
+	}
+}
+
+type (
+	readCacheContextKeyType int
+)
+
+var (
+	readCacheContextKey readCacheContextKeyType
+)
+
+// NewReadCacheContext returns a copy of the Context that carries the provider's read cache, if enabled.
+// Only Read operations on resources should be passed such a Context.
+func (c *AWSClient) NewReadCacheContext(ctx context.Context) context.Context {
+	if c.readCache == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, readCacheContextKey, c.readCache)
+}
+
+// InvalidateReadCache discards all cached reads for the specified service package.
+func (c *AWSClient) InvalidateReadCache(servicePackageName string) {
+	if c.readCache == nil {
+		return
+	}
+
+	c.readCache.lock.Lock()
+	defer c.readCache.lock.Unlock()
+
+	delete(c.readCache.entries, servicePackageName)
+}
+
+// ReadCacheEnabled returns whether reads made with the Context are cached.
+func ReadCacheEnabled(ctx context.Context) bool {
+	_, ok := ctx.Value(readCacheContextKey).(*readCache)
+	return ok
+}
+
+// ReadThrough returns the cached result of the read identified by the service package name and key.
+// If there is no cached result, f is called and successful results are cached.
+// Concurrent calls for the same key wait for the first call's result.
+// If the Context does not carry a read cache (see NewReadCacheContext), f is called directly.
+// The key must identify a single parent object, e.g. "SecurityGroup/sg-0123456789abcdef0",
+// and any per-resource AWS Region override is added to it. Cached values are shared and must not be modified.
+func ReadThrough[T any](ctx context.Context, servicePackageName, key string, f func(context.Context) (T, error)) (T, error) {
+	cache, ok := ctx.Value(readCacheContextKey).(*readCache)
+	if !ok {
+		return f(ctx)
+	}
+
+	if inContext, ok := FromContext(ctx); ok && inContext.Region != "" {
+		key = inContext.Region + "/" + key
+	}
+
+	cache.lock.Lock()
+	entries, ok := cache.entries[servicePackageName]
+	if !ok {
+		entries = make(map[string]*readCacheEntry)
+		cache.entries[servicePackageName] = entries
+	}
+	entry, ok := entries[key]
+	if !ok {
+		entry = &readCacheEntry{
+			done: make(chan struct{}),
+		}
+		entries[key] = entry
+	}
+	cache.lock.Unlock()
+
+	if ok {
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+
+		if entry.err != nil {
+			return f(ctx)
+		}
+
+		if v, ok := entry.value.(T); ok {
+			tflog.Debug(ctx, "Read cache hit", map[string]any{
+				"tf_aws.read_cache_key": key,
+			})
+
+			return v, nil
+		}
+
+		return f(ctx)
+	}
+
+	v, err := f(ctx)
+
+	entry.value, entry.err = v, err
+	close(entry.done)
+
+	// Errors are not cached.
+	if err != nil {
+		cache.lock.Lock()
+		if entries, ok := cache.entries[servicePackageName]; ok && entries[key] == entry {
+			delete(entries, key)
+		}
+		cache.lock.Unlock()
+	}
+
+	return v, err
+}
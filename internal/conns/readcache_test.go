@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestReadThrough(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	f := func(context.Context) (string, error) {
+		calls.Add(1)
+		return "value", nil
+	}
+
+	// Disabled.
+	ctx := (&AWSClient{}).NewReadCacheContext(context.Background())
+
+	if ReadCacheEnabled(ctx) {
+		t.Error("read cache enabled, expected disabled")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := ReadThrough(ctx, "ec2", "SecurityGroup/sg-1", f); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	// Enabled.
+	calls.Store(0)
+	client := &AWSClient{
+		readCache: newReadCache(),
+	}
+	ctx = client.NewReadCacheContext(context.Background())
+
+	if !ReadCacheEnabled(ctx) {
+		t.Error("read cache disabled, expected enabled")
+	}
+
+	for i := 0; i < 2; i++ {
+		v, err := ReadThrough(ctx, "ec2", "SecurityGroup/sg-1", f)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := v, "value"; got != want {
+			t.Errorf("value = %s, want %s", got, want)
+		}
+	}
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	// Different key and per-resource Region.
+	if _, err := ReadThrough(ctx, "ec2", "SecurityGroup/sg-2", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := ReadThrough(NewRegionContext(ctx, "eu-west-1"), "ec2", "SecurityGroup/sg-1", f); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(3); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	// Invalidation.
+	client.InvalidateReadCache("route53")
+
+	if _, err := ReadThrough(ctx, "ec2", "SecurityGroup/sg-1", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(3); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	client.InvalidateReadCache("ec2")
+
+	if _, err := ReadThrough(ctx, "ec2", "SecurityGroup/sg-1", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(4); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestReadThroughCoalescing(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		readCache: newReadCache(),
+	}
+	ctx := client.NewReadCacheContext(context.Background())
+
+	const n = 10
+	var calls atomic.Int32
+	release := make(chan struct{})
+	f := func(context.Context) (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			v, err := ReadThrough(ctx, "route53", "ResourceRecordSets/Z123", f)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			results[i] = v
+		}(i)
+	}
+
+	// Callers that arrive while the first call is blocked wait for its result,
+	// later callers are served from the cache.
+	close(release)
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+	for i, v := range results {
+		if got, want := v, 42; got != want {
+			t.Errorf("results[%d] = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestReadThroughErrorsNotCached(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		readCache: newReadCache(),
+	}
+	ctx := client.NewReadCacheContext(context.Background())
+
+	var calls atomic.Int32
+	f := func(context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			return "", errors.New("throttled")
+		}
+		return "value", nil
+	}
+
+	if _, err := ReadThrough(ctx, "iam", "AttachedRolePolicies/role", f); err == nil {
+		t.Fatal("expected error")
+	}
+
+	v, err := ReadThrough(ctx, "iam", "AttachedRolePolicies/role", f)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := v, "value"; got != want {
+		t.Errorf("value = %s, want %s", got, want)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportIDRegionSeparator separates a resource's import ID from an optional AWS Region,
+// e.g. `vpc-0123456789abcdef0@us-west-2`.
+const ImportIDRegionSeparator = "@"
+
+// SplitImportIDRegion splits an import ID of the form `<id>@<region>` into its ID and AWS Region parts.
+// If the import ID does not end in a well-formed AWS Region it is returned unchanged with an empty Region,
+// so IDs that legitimately contain the separator (e.g. email addresses) are not affected.
+func SplitImportIDRegion(id string) (string, string) {
+	if i := strings.LastIndex(id, ImportIDRegionSeparator); i > 0 {
+		if region := id[i+len(ImportIDRegionSeparator):]; types.IsAWSRegion(region) {
+			return id[:i], region
+		}
+	}
+
+	return id, ""
+}
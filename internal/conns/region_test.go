@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "empty",
+			ID:         "",
+			ExpectedID: "",
+		},
+		{
+			Name:       "no region",
+			ID:         "vpc-0123456789abcdef0",
+			ExpectedID: "vpc-0123456789abcdef0",
+		},
+		{
+			Name:           "region",
+			ID:             "vpc-0123456789abcdef0@us-west-2", //lintignore:AWSAT003
+			ExpectedID:     "vpc-0123456789abcdef0",
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "composite ID with region",
+			ID:             "rtb-0123456789abcdef0_10.0.0.0/16@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "rtb-0123456789abcdef0_10.0.0.0/16",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:       "email address",
+			ID:         "someone@example.com",
+			ExpectedID: "someone@example.com",
+		},
+		{
+			Name:       "leading separator",
+			ID:         "@us-west-2", //lintignore:AWSAT003
+			ExpectedID: "@us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.ID)
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("ID: got %s, expected %s", gotID, testCase.ExpectedID)
+			}
+			if gotRegion != testCase.ExpectedRegion {
+				t.Errorf("region: got %s, expected %s", gotRegion, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"path"
+	"time"
+
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Timeouts represents per-operation timeout values. Zero values are unset.
+type Timeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// DefaultTimeouts returns the provider configured default timeouts for the specified resource type.
+// Default timeouts are keyed by resource type, e.g. `aws_db_instance`, or by glob pattern, e.g. `aws_rds_*`.
+// A resource type key takes precedence over any matching pattern, and longer patterns over shorter ones.
+func (c *AWSClient) DefaultTimeouts(typeName string) (Timeouts, bool) {
+	if v, ok := c.defaultTimeouts[typeName]; ok {
+		return v, true
+	}
+
+	patterns := tfslices.Filter(tfmaps.Keys(c.defaultTimeouts), func(v string) bool {
+		ok, err := path.Match(v, typeName)
+		return err == nil && ok
+	})
+
+	if len(patterns) == 0 {
+		return Timeouts{}, false
+	}
+
+	pattern := patterns[0]
+	for _, v := range patterns[1:] {
+		if len(v) > len(pattern) || (len(v) == len(pattern) && v < pattern) {
+			pattern = v
+		}
+	}
+
+	return c.defaultTimeouts[pattern], true
+}
+
+type (
+	timeoutsContextKeyType int
+)
+
+var (
+	timeoutsContextKey timeoutsContextKeyType
+)
+
+// NewDefaultTimeoutsContext returns a copy of the Context that carries the provider configured default timeouts
+// for the specified resource type, if any.
+func (c *AWSClient) NewDefaultTimeoutsContext(ctx context.Context, typeName string) context.Context {
+	v, ok := c.DefaultTimeouts(typeName)
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, timeoutsContextKey, v)
+}
+
+// DefaultTimeoutsFromContext returns the provider configured default timeouts carried by the Context.
+func DefaultTimeoutsFromContext(ctx context.Context) (Timeouts, bool) {
+	v, ok := ctx.Value(timeoutsContextKey).(Timeouts)
+	return v, ok
+}
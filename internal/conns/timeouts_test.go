@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAWSClientDefaultTimeouts(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		defaultTimeouts: map[string]Timeouts{
+			"aws_*": {
+				Delete: 5 * time.Minute,
+			},
+			"aws_db_*": {
+				Create: 90 * time.Minute,
+			},
+			"aws_db_instance": {
+				Create: 120 * time.Minute,
+			},
+			"aws_eks_*": {
+				Update: 60 * time.Minute,
+			},
+			"aws_*_cluster": {
+				Update: 45 * time.Minute,
+			},
+		},
+	}
+
+	testCases := []struct {
+		typeName string
+		expected Timeouts
+	}{
+		{
+			typeName: "aws_db_instance",
+			expected: Timeouts{Create: 120 * time.Minute},
+		},
+		{
+			typeName: "aws_db_subnet_group",
+			expected: Timeouts{Create: 90 * time.Minute},
+		},
+		{
+			typeName: "aws_eks_cluster",
+			expected: Timeouts{Update: 45 * time.Minute},
+		},
+		{
+			typeName: "aws_eks_node_group",
+			expected: Timeouts{Update: 60 * time.Minute},
+		},
+		{
+			typeName: "aws_vpc",
+			expected: Timeouts{Delete: 5 * time.Minute},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.typeName, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := client.DefaultTimeouts(testCase.typeName)
+			if !ok {
+				t.Fatal("no default timeouts")
+			}
+			if got != testCase.expected {
+				t.Errorf("default timeouts = %+v, want %+v", got, testCase.expected)
+			}
+
+			got, ok = DefaultTimeoutsFromContext(client.NewDefaultTimeoutsContext(context.Background(), testCase.typeName))
+			if !ok {
+				t.Fatal("no default timeouts in Context")
+			}
+			if got != testCase.expected {
+				t.Errorf("default timeouts in Context = %+v, want %+v", got, testCase.expected)
+			}
+		})
+	}
+
+	if _, ok := (&AWSClient{}).DefaultTimeouts("aws_vpc"); ok {
+		t.Error("unexpected default timeouts")
+	}
+}
@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"log"
 	"os"
 	"strconv"
@@ -46,6 +47,21 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 	return semaphore
 }
 
+// NewSemaphore returns a semaphore that permits at most limit concurrent executions.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
+// WaitContext waits for a semaphore before continuing, or until the Context is done.
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Wait waits for a semaphore before continuing
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSemaphoreWaitContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	semaphore := NewSemaphore(1)
+
+	if err := semaphore.WaitContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := semaphore.WaitContext(ctx2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	semaphore.Notify()
+
+	if err := semaphore.WaitContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
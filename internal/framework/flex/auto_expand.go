@@ -41,7 +41,10 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
-type autoExpander struct{}
+type autoExpander struct {
+	// unionMembers are the registered member types of Smithy union (interface) types.
+	unionMembers []reflect.Type
+}
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
 func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
@@ -327,6 +330,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object --> interface.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -633,7 +645,7 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 			//
 			// types.List(OfObject) -> []interface.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, tElem, vTo)...)
 			return diags
 		}
 
@@ -641,7 +653,7 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 		//
 		// types.List(OfObject) -> interface.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
 		return diags
 	}
 
@@ -713,6 +725,154 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union (interface) value.
+// Exactly one of the nested Object's attributes may be set. It is copied to the `Value` field of the union member
+// whose name matches the attribute's name.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members := expander.unionMemberTypes(tUnion)
+	if len(members) == 0 {
+		tflog.Info(ctx, "AutoFlex Expand; no union member types registered", map[string]interface{}{
+			"to": tUnion.String(),
+		})
+		return diags
+	}
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	to, d := expander.structToUnion(ctx, reflect.ValueOf(from), tUnion, members)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to.IsValid() {
+		vTo.Set(to)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []interface value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members := expander.unionMemberTypes(tUnion)
+	if len(members) == 0 {
+		tflog.Info(ctx, "AutoFlex Expand; no union member types registered", map[string]interface{}{
+			"to": tUnion.String(),
+		})
+		return diags
+	}
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, n, n)
+	for i := 0; i < n; i++ {
+		to, d := expander.structToUnion(ctx, f.Index(i), tUnion, members)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.IsValid() {
+			t.Index(i).Set(to)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// structToUnion returns a new union member containing the single set field of the Plugin Framework (*)struct value.
+// An invalid value is returned if no field is set.
+func (expander autoExpander) structToUnion(ctx context.Context, valFrom reflect.Value, tUnion reflect.Type, members []reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if valFrom.Kind() == reflect.Ptr {
+		if valFrom.IsNil() {
+			return reflect.Value{}, diags
+		}
+		valFrom = valFrom.Elem()
+	}
+
+	var to reflect.Value
+	var memberName string
+
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		v, ok := valFrom.Field(i).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if to.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): more than one member set (%s, %s)", tUnion, memberName, field.Name))
+			return reflect.Value{}, diags
+		}
+
+		tMember := findUnionMemberType(tUnion, members, field.Name)
+		if tMember == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union (%s): no member type registered for %s", tUnion, field.Name))
+			return reflect.Value{}, diags
+		}
+
+		member := reflect.New(tMember)
+		fieldTo := member.Elem().FieldByName(unionMemberValueFieldName)
+		if !fieldTo.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union member (%s): no %s field", tMember, unionMemberValueFieldName))
+			return reflect.Value{}, diags
+		}
+
+		diags.Append(expander.convert(ctx, valFrom.Field(i), fieldTo)...)
+		if diags.HasError() {
+			return reflect.Value{}, diags
+		}
+
+		// AWS SDK for Go v2 union members implement the union interface with pointer receivers.
+		if member.Type().Implements(tUnion) {
+			to = member
+		} else {
+			to = member.Elem()
+		}
+		memberName = field.Name
+	}
+
+	return to, diags
+}
+
+// unionMemberTypes returns the registered member types of the specified union (interface) type.
+func (expander autoExpander) unionMemberTypes(tUnion reflect.Type) []reflect.Type {
+	var members []reflect.Type
+
+	for _, tMember := range expander.unionMembers {
+		if tMember.Implements(tUnion) || reflect.PointerTo(tMember).Implements(tUnion) {
+			members = append(members, tMember)
+		}
+	}
+
+	return members
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
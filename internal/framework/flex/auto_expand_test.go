@@ -865,9 +865,100 @@ func TestExpandComplexNestedBlockWithStringEnum(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unionMembers := []AutoFlexOptionsFunc{
+		WithUnionMembers(TestFlexUnionMemberStringValue{}, &TestFlexUnionMemberObjectValue{}),
+	}
+	testCases := autoFlexTestCases{
+		{
+			TestName: "string member",
+			Options:  unionMembers,
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringValue("a"),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionMemberStringValue{Value: "a"}},
+		},
+		{
+			TestName: "object member",
+			Options:  unionMembers,
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringNull(),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexUnionMemberObjectValue{Value: TestFlexAWS01{Field1: "b"}}},
+		},
+		{
+			TestName: "no member",
+			Options:  unionMembers,
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringNull(),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "multiple members",
+			Options:  unionMembers,
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringValue("a"),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "unregistered member",
+			Options:  []AutoFlexOptionsFunc{WithUnionMembers(TestFlexUnionMemberStringValue{})},
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringNull(),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "no members registered",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringValue("a"),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "slice of union",
+			Options:  unionMembers,
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+				{
+					StringValue: types.StringValue("a"),
+					ObjectValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+				{
+					StringValue: types.StringNull(),
+					ObjectValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+				},
+			})},
+			Target: &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: []TestFlexUnion{
+				&TestFlexUnionMemberStringValue{Value: "a"},
+				&TestFlexUnionMemberObjectValue{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	TestName   string
+	Options    []AutoFlexOptionsFunc
 	Source     any
 	Target     any
 	WantErr    bool
@@ -889,7 +980,7 @@ func runAutoExpandTestCases(ctx context.Context, t *testing.T, testCases autoFle
 				testCtx = testCase.Context
 			}
 
-			err := Expand(testCtx, testCase.Source, testCase.Target)
+			err := Expand(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
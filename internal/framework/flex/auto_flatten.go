@@ -331,6 +331,13 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 
 		vTo.Set(reflect.ValueOf(v))
 		return diags
+
+	case fwtypes.NestedObjectType:
+		//
+		// interface -> types.List(OfObject) or types.Object.
+		//
+		diags.Append(flattener.unionToNestedObject(ctx, vFrom, isNullFrom || vFrom.IsNil(), tTo, vTo)...)
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -539,8 +546,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
-		return diags
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			//
+			// []interface -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -924,3 +936,157 @@ func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 
 	return diags
 }
+
+// unionToNestedObject copies an AWS API union (interface) value to a compatible Plugin Framework NestedObjectValue value.
+// The union member's `Value` field is copied to the nested Object's attribute whose name matches the member's name.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isNullFrom {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and copy the union member.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.unionToStruct(ctx, vFrom, to)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []interface value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if v := vFrom.Index(i); !v.IsNil() {
+			diags.Append(flattener.unionToStruct(ctx, v, target)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// unionToStruct copies the `Value` field of an AWS API union member to the corresponding field of a Plugin Framework *struct value.
+func (flattener autoFlattener) unionToStruct(ctx context.Context, vFrom reflect.Value, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tUnion := vFrom.Type()
+	vMember := vFrom.Elem()
+	if vMember.Kind() == reflect.Ptr {
+		vMember = vMember.Elem()
+	}
+
+	memberName, ok := unionMemberName(tUnion, vMember.Type())
+	if !ok {
+		// e.g. an UnknownUnionMember returned by a newer API version.
+		tflog.Info(ctx, "AutoFlex Flatten; unsupported union member type", map[string]interface{}{
+			"from": vMember.Type().String(),
+			"to":   fmt.Sprintf("%T", to),
+		})
+		return diags
+	}
+
+	vValue := vMember.FieldByName(unionMemberValueFieldName)
+	if !vValue.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member (%s): no %s field", vMember.Type(), unionMemberValueFieldName))
+		return diags
+	}
+
+	// Only the field corresponding to the union member is set.
+	valTo := reflect.ValueOf(to).Elem()
+	for i := 0; i < valTo.NumField(); i++ {
+		field := valTo.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		v, err := fwtypes.NullValueOf(ctx, field.Interface())
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+
+		if v != nil {
+			field.Set(reflect.ValueOf(v))
+		}
+	}
+
+	fieldTo := findFieldFuzzy(ctx, memberName, valTo, vMember)
+	if !fieldTo.IsValid() || !fieldTo.CanSet() {
+		tflog.Info(ctx, "AutoFlex Flatten; no field for union member", map[string]interface{}{
+			"from": vMember.Type().String(),
+			"to":   fmt.Sprintf("%T", to),
+		})
+		return diags
+	}
+
+	diags.Append(flattener.convert(ctx, vValue, fieldTo)...)
+	if diags.HasError() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", memberName))
+		return diags
+	}
+
+	return diags
+}
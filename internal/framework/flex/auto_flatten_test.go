@@ -905,6 +905,57 @@ func TestFlattenComplexSingleNestedBlock(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "string member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionMemberStringValue{Value: "a"}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringValue("a"),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			})},
+		},
+		{
+			TestName: "object member",
+			Source:   &TestFlexUnionAWS01{Field1: &TestFlexUnionMemberObjectValue{Value: TestFlexAWS01{Field1: "b"}}},
+			Target:   &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				StringValue: types.StringNull(),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+		},
+		{
+			TestName:   "nil union",
+			Source:     &TestFlexUnionAWS01{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+		{
+			TestName: "slice of union",
+			Source: &TestFlexUnionAWS02{Field1: []TestFlexUnion{
+				&TestFlexUnionMemberStringValue{Value: "a"},
+				&TestFlexUnionMemberObjectValue{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+			Target: &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+				{
+					StringValue: types.StringValue("a"),
+					ObjectValue: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				},
+				{
+					StringValue: types.StringNull(),
+					ObjectValue: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+				},
+			})},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -918,7 +969,7 @@ func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFl
 				testCtx = testCase.Context
 			}
 
-			err := Flatten(testCtx, testCase.Source, testCase.Target)
+			err := Flatten(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
@@ -32,6 +32,23 @@ type autoFlexer interface {
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(autoFlexer)
 
+// WithUnionMembers registers the member types of AWS API union types, which are modeled as Go interfaces.
+// Union member types can't be discovered by reflection so must be registered in order to Expand into a union.
+// For example, `flex.WithUnionMembers(awstypes.ConfigurationMemberS3{}, awstypes.ConfigurationMemberSns{})`.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		if expander, ok := flexer.(*autoExpander); ok {
+			for _, member := range members {
+				tMember := reflect.TypeOf(member)
+				if tMember.Kind() == reflect.Ptr {
+					tMember = tMember.Elem()
+				}
+				expander.unionMembers = append(expander.unionMembers, tMember)
+			}
+		}
+	}
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -182,3 +199,34 @@ func fieldExistsInStruct(field string, str reflect.Value) bool {
 
 	return false
 }
+
+const (
+	// unionMemberValueFieldName is the name of the field holding an AWS API union member's value.
+	unionMemberValueFieldName = "Value"
+)
+
+// unionMemberName returns the name of an AWS API union member given the union's interface type and the member's type.
+// Union member types are named `<Union>Member<Name>`, e.g. `ConfigurationMemberS3`.
+func unionMemberName(tUnion, tMember reflect.Type) (string, bool) {
+	if tMember.PkgPath() != tUnion.PkgPath() {
+		return "", false
+	}
+
+	name, ok := strings.CutPrefix(tMember.Name(), tUnion.Name()+"Member")
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
+// findUnionMemberType returns the union member type whose name matches the specified field name, or nil if none match.
+func findUnionMemberType(tUnion reflect.Type, members []reflect.Type, fieldName string) reflect.Type {
+	for _, tMember := range members {
+		if name, ok := unionMemberName(tUnion, tMember); ok && strings.EqualFold(name, fieldName) {
+			return tMember
+		}
+	}
+
+	return nil
+}
@@ -339,3 +339,37 @@ type TestFlexAWS19 struct {
 type TestFlexTF19 struct {
 	Field1 types.String `tfsdk:"field1"`
 }
+
+// TestFlexUnion is an AWS API union type.
+type TestFlexUnion interface {
+	isTestFlexUnion()
+}
+
+type TestFlexUnionMemberStringValue struct {
+	Value string
+}
+
+func (*TestFlexUnionMemberStringValue) isTestFlexUnion() {}
+
+type TestFlexUnionMemberObjectValue struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexUnionMemberObjectValue) isTestFlexUnion() {}
+
+type TestFlexUnionTF01 struct {
+	StringValue types.String                                  `tfsdk:"string_value"`
+	ObjectValue fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"object_value"`
+}
+
+type TestFlexUnionTF02 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field1"`
+}
+
+type TestFlexUnionAWS01 struct {
+	Field1 TestFlexUnion
+}
+
+type TestFlexUnionAWS02 struct {
+	Field1 []TestFlexUnion
+}
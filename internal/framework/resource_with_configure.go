@@ -52,6 +52,16 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+			// Check the merged tags against any provider-level tag policy.
+			policyConfig := r.Meta().TagPolicyConfig
+			for _, err := range policyConfig.Violations(allTags) {
+				if policyConfig.IsError() {
+					response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", err.Error())
+				} else {
+					response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag Policy Violation", err.Error())
+				}
+			}
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
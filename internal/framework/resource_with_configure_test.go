@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestResourceWithConfigureSetTagsAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags":     schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"tags_all": schema.MapAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+	planType := resourceSchema.Type().TerraformType(ctx)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+
+	policyConfig := func(enforcement tftags.PolicyEnforcement) *tftags.PolicyConfig {
+		return &tftags.PolicyConfig{
+			Enforcement:  enforcement,
+			KeyCase:      tftags.KeyCasePascal,
+			RequiredKeys: []string{"Owner"},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		policyConfig      *tftags.PolicyConfig
+		defaultTagsConfig *tftags.DefaultConfig
+		tags              tftypes.Value
+		expectedTagsAll   tftypes.Value
+		expectedSeverity  diag.Severity
+		expectedDiags     int
+	}{
+		{
+			name: "no policy",
+			tags: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, "x"),
+			}),
+			expectedTagsAll: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, "x"),
+			}),
+		},
+		{
+			name:         "required key from default tags",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			defaultTagsConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{"Owner": "me"}),
+			},
+			tags: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"CostCenter": tftypes.NewValue(tftypes.String, "x"),
+			}),
+			expectedTagsAll: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"CostCenter": tftypes.NewValue(tftypes.String, "x"),
+				"Owner":      tftypes.NewValue(tftypes.String, "me"),
+			}),
+		},
+		{
+			name:         "violation warning",
+			policyConfig: policyConfig(tftags.PolicyEnforcementWarning),
+			tags: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, "x"),
+			}),
+			expectedTagsAll: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, "x"),
+			}),
+			expectedSeverity: diag.SeverityWarning,
+			expectedDiags:    2,
+		},
+		{
+			name:         "violation error",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			tags: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, "x"),
+			}),
+			expectedTagsAll: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, "x"),
+			}),
+			expectedSeverity: diag.SeverityError,
+			expectedDiags:    2,
+		},
+		{
+			name:         "unknown tags",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			tags: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"cost-center": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expectedTagsAll: tftypes.NewValue(mapType, tftypes.UnknownValue),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r ResourceWithConfigure
+			r.meta = &conns.AWSClient{
+				DefaultTagsConfig: testCase.defaultTagsConfig,
+				TagPolicyConfig:   testCase.policyConfig,
+			}
+
+			plan := tfsdk.Plan{
+				Schema: resourceSchema,
+				Raw: tftypes.NewValue(planType, map[string]tftypes.Value{
+					"tags":     testCase.tags,
+					"tags_all": tftypes.NewValue(mapType, tftypes.UnknownValue),
+				}),
+			}
+			request := resource.ModifyPlanRequest{
+				Plan: plan,
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: plan,
+			}
+
+			r.SetTagsAll(ctx, request, &response)
+
+			if got, want := len(response.Diagnostics), testCase.expectedDiags; got != want {
+				t.Fatalf("length of diags = %d, want %d: %v", got, want, response.Diagnostics)
+			}
+			for _, d := range response.Diagnostics {
+				if got, want := d.Severity(), testCase.expectedSeverity; got != want {
+					t.Errorf("severity = %v, want %v", got, want)
+				}
+			}
+
+			expected := tftypes.NewValue(planType, map[string]tftypes.Value{
+				"tags":     testCase.tags,
+				"tags_all": testCase.expectedTagsAll,
+			})
+			if !response.Plan.Raw.Equal(expected) {
+				t.Errorf("plan = %v, want %v", response.Plan.Raw, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// providerAddressSuffix is the suffix of this provider's address, e.g. "registry.terraform.io/hashicorp/aws".
+	providerAddressSuffix = "hashicorp/aws"
+)
+
+// StateMove declares how a Terraform `moved` block moves the state of a resource of one type to a resource of another type.
+// See https://developer.hashicorp.com/terraform/plugin/framework/resources/state-move.
+//
+// The target state is built from the source state in the following order:
+//   - CopyAttributes copies top-level attributes that have the same name in the source and target schemas
+//   - Attributes maps source attribute values to target attributes
+//   - Transform makes any further changes
+//
+// Target attributes that are not set are null.
+type StateMove struct {
+	// SourceTypeName is the source resource type, e.g. "aws_s3_bucket_object".
+	SourceTypeName string
+	// SourceSchemaVersion is the source resource type's current schema version.
+	// Source state at a different schema version cannot be moved.
+	SourceSchemaVersion int64
+	// TargetTypeName is the target resource type, e.g. "aws_s3_object".
+	TargetTypeName string
+
+	// CopyAttributes copies every top-level source attribute that has the same name in the target schema.
+	CopyAttributes bool
+	// Attributes maps target attribute names to source attribute paths.
+	// Path steps are separated by '.' and list elements are addressed by index, e.g. "logging.0.target_bucket".
+	Attributes map[string]string
+	// Required lists the source attribute paths that must have non-empty values for the state to be moved.
+	Required []string
+	// Transform, if set, makes further changes to the target state.
+	// Both states are decoded from JSON, with numbers as json.Number values.
+	Transform func(ctx context.Context, source, target map[string]any) error
+}
+
+// matches returns whether the move applies to a source resource of the specified type from the specified provider.
+func (m *StateMove) matches(sourceTypeName, sourceProviderAddress string) bool {
+	return sourceTypeName == m.SourceTypeName && strings.HasSuffix(sourceProviderAddress, providerAddressSuffix)
+}
+
+// Move returns the target state, of the specified type, for the specified source state in JSON format.
+func (m *StateMove) Move(ctx context.Context, sourceSchemaVersion int64, sourceJSON []byte, targetType tftypes.Type) (tftypes.Value, error) {
+	if sourceSchemaVersion != m.SourceSchemaVersion {
+		return tftypes.Value{}, fmt.Errorf("%s schema version (%d) is not supported, expected %d. Refresh the resource's state with the current provider version before moving it", m.SourceTypeName, sourceSchemaVersion, m.SourceSchemaVersion)
+	}
+
+	if len(sourceJSON) == 0 {
+		return tftypes.Value{}, fmt.Errorf("%s state is empty", m.SourceTypeName)
+	}
+
+	// Preserve the precision of numbers.
+	decoder := json.NewDecoder(bytes.NewReader(sourceJSON))
+	decoder.UseNumber()
+
+	var source map[string]any
+	if err := decoder.Decode(&source); err != nil {
+		return tftypes.Value{}, fmt.Errorf("decoding %s state: %w", m.SourceTypeName, err)
+	}
+
+	for _, path := range m.Required {
+		if v, ok := AttributeAtPath(source, path); !ok || isEmptyAttribute(v) {
+			return tftypes.Value{}, fmt.Errorf("%s has no %s, so it cannot be moved to %s", m.SourceTypeName, path, m.TargetTypeName)
+		}
+	}
+
+	target := make(map[string]any)
+
+	if m.CopyAttributes {
+		if typ, ok := targetType.(tftypes.Object); ok {
+			for k := range typ.AttributeTypes {
+				if v, ok := source[k]; ok {
+					target[k] = v
+				}
+			}
+		}
+	}
+
+	for k, path := range m.Attributes {
+		if v, ok := AttributeAtPath(source, path); ok {
+			target[k] = v
+		}
+	}
+
+	if m.Transform != nil {
+		if err := m.Transform(ctx, source, target); err != nil {
+			return tftypes.Value{}, fmt.Errorf("moving %s to %s: %w", m.SourceTypeName, m.TargetTypeName, err)
+		}
+	}
+
+	targetJSON, err := json.Marshal(target)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("encoding %s state: %w", m.TargetTypeName, err)
+	}
+
+	rawState := tfprotov5.RawState{JSON: targetJSON}
+	value, err := rawState.UnmarshalWithOpts(targetType, tfprotov5.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("decoding %s state: %w", m.TargetTypeName, err)
+	}
+
+	return value, nil
+}
+
+// StateMover returns a Terraform Plugin Framework state mover for the move.
+// Use from the MoveState method of target resources implemented using Terraform Plugin Framework.
+func (m *StateMove) StateMover() resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			if !m.matches(request.SourceTypeName, request.SourceProviderAddress) {
+				return
+			}
+
+			if request.SourceRawState == nil {
+				response.Diagnostics.AddError("Moving Resource State", fmt.Sprintf("%s state is empty", m.SourceTypeName))
+				return
+			}
+
+			value, err := m.Move(ctx, request.SourceSchemaVersion, request.SourceRawState.JSON, response.TargetState.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				response.Diagnostics.AddError("Moving Resource State", err.Error())
+				return
+			}
+
+			response.TargetState.Raw = value
+		},
+	}
+}
+
+// AttributeAtPath returns the value at the specified path in a resource's state in JSON format.
+// Path steps are separated by '.' and list elements are addressed by index, e.g. "logging.0.target_bucket".
+func AttributeAtPath(state map[string]any, path string) (any, bool) {
+	var v any = state
+
+	for _, step := range strings.Split(path, ".") {
+		switch tv := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = tv[step]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= len(tv) {
+				return nil, false
+			}
+			v = tv[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+func isEmptyAttribute(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
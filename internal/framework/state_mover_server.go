@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// NewStateMoverProviderServer returns a provider server that handles MoveResourceState requests for the specified moves,
+// forwarding all other requests to the wrapped provider server.
+// Use to move state to target resources implemented using Terraform Plugin SDK, which doesn't support moving state across resource types.
+func NewStateMoverProviderServer(server tfprotov5.ProviderServer, moves []*StateMove) tfprotov5.ProviderServer {
+	s := &stateMoverProviderServer{
+		ProviderServer: server,
+		moves:          make(map[string][]*StateMove),
+	}
+
+	for _, move := range moves {
+		s.moves[move.TargetTypeName] = append(s.moves[move.TargetTypeName], move)
+	}
+
+	return s
+}
+
+var (
+	_ tfprotov5.ResourceServerWithMoveResourceState = (*stateMoverProviderServer)(nil) //nolint:staticcheck // MoveResourceState is not yet part of the ResourceServer interface.
+)
+
+type stateMoverProviderServer struct {
+	tfprotov5.ProviderServer
+	moves map[string][]*StateMove // Keyed by target resource type.
+}
+
+func (s *stateMoverProviderServer) GetMetadata(ctx context.Context, request *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	response, err := s.ProviderServer.GetMetadata(ctx, request)
+
+	if response != nil && len(s.moves) > 0 {
+		response.ServerCapabilities = withMoveResourceState(response.ServerCapabilities)
+	}
+
+	return response, err
+}
+
+func (s *stateMoverProviderServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	response, err := s.ProviderServer.GetProviderSchema(ctx, request)
+
+	if response != nil && len(s.moves) > 0 {
+		response.ServerCapabilities = withMoveResourceState(response.ServerCapabilities)
+	}
+
+	return response, err
+}
+
+func (s *stateMoverProviderServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	var move *StateMove
+	for _, v := range s.moves[request.TargetTypeName] {
+		if v.matches(request.SourceTypeName, request.SourceProviderAddress) {
+			move = v
+			break
+		}
+	}
+
+	if move == nil {
+		//nolint:staticcheck // MoveResourceState is not yet part of the ResourceServer interface.
+		if v, ok := s.ProviderServer.(tfprotov5.ResourceServerWithMoveResourceState); ok {
+			return v.MoveResourceState(ctx, request)
+		}
+
+		return &tfprotov5.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{
+				moveResourceStateErrorDiagnostic(fmt.Errorf("%s does not support moving resource state from %s", request.TargetTypeName, request.SourceTypeName)),
+			},
+		}, nil
+	}
+
+	response := &tfprotov5.MoveResourceStateResponse{}
+
+	schemaResponse, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := schemaResponse.ResourceSchemas[request.TargetTypeName]
+	if !ok {
+		response.Diagnostics = append(response.Diagnostics, moveResourceStateErrorDiagnostic(fmt.Errorf("%s schema not found", request.TargetTypeName)))
+		return response, nil
+	}
+
+	var sourceJSON []byte
+	if request.SourceState != nil {
+		sourceJSON = request.SourceState.JSON
+	}
+
+	typ := schema.ValueType()
+	value, err := move.Move(ctx, request.SourceSchemaVersion, sourceJSON, typ)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, moveResourceStateErrorDiagnostic(err))
+		return response, nil
+	}
+
+	targetState, err := tfprotov5.NewDynamicValue(typ, value)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, moveResourceStateErrorDiagnostic(err))
+		return response, nil
+	}
+
+	response.TargetState = &targetState
+
+	return response, nil
+}
+
+func withMoveResourceState(capabilities *tfprotov5.ServerCapabilities) *tfprotov5.ServerCapabilities {
+	if capabilities == nil {
+		capabilities = &tfprotov5.ServerCapabilities{}
+	}
+	capabilities.MoveResourceState = true
+
+	return capabilities
+}
+
+func moveResourceStateErrorDiagnostic(err error) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Moving Resource State",
+		Detail:   err.Error(),
+	}
+}
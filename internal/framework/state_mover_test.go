@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateMoveMove(t *testing.T) {
+	t.Parallel()
+
+	targetType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":            tftypes.String,
+			"bucket":        tftypes.String,
+			"target_bucket": tftypes.String,
+			"size":          tftypes.Number,
+			"tags":          tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		move                *StateMove
+		sourceSchemaVersion int64
+		sourceJSON          string
+		expected            tftypes.Value
+		expectError         bool
+	}{
+		{
+			name: "copy attributes",
+			move: &StateMove{
+				CopyAttributes: true,
+			},
+			sourceJSON: `{"id":"example","bucket":"example","size":9007199254740993,"tags":{"Name":"example"},"acl":"private"}`,
+			expected: tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "example"),
+				"bucket":        tftypes.NewValue(tftypes.String, "example"),
+				"target_bucket": tftypes.NewValue(tftypes.String, nil),
+				"size":          tftypes.NewValue(tftypes.Number, 9007199254740993),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Name": tftypes.NewValue(tftypes.String, "example"),
+				}),
+			}),
+		},
+		{
+			name: "attributes",
+			move: &StateMove{
+				Attributes: map[string]string{
+					"id":            "bucket",
+					"bucket":        "bucket",
+					"target_bucket": "logging.0.target_bucket",
+				},
+				Required: []string{"logging.0.target_bucket"},
+			},
+			sourceJSON: `{"id":"example","bucket":"example","logging":[{"target_bucket":"logs"}]}`,
+			expected: tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "example"),
+				"bucket":        tftypes.NewValue(tftypes.String, "example"),
+				"target_bucket": tftypes.NewValue(tftypes.String, "logs"),
+				"size":          tftypes.NewValue(tftypes.Number, nil),
+				"tags":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+		},
+		{
+			name: "transform",
+			move: &StateMove{
+				Attributes: map[string]string{
+					"bucket": "bucket",
+				},
+				Transform: func(_ context.Context, source, target map[string]any) error {
+					target["id"] = "prefix-" + source["bucket"].(string)
+					return nil
+				},
+			},
+			sourceJSON: `{"bucket":"example"}`,
+			expected: tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "prefix-example"),
+				"bucket":        tftypes.NewValue(tftypes.String, "example"),
+				"target_bucket": tftypes.NewValue(tftypes.String, nil),
+				"size":          tftypes.NewValue(tftypes.Number, nil),
+				"tags":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+		},
+		{
+			name: "required attribute missing",
+			move: &StateMove{
+				Required: []string{"logging.0.target_bucket"},
+			},
+			sourceJSON:  `{"id":"example","bucket":"example","logging":[]}`,
+			expectError: true,
+		},
+		{
+			name:                "schema version mismatch",
+			move:                &StateMove{},
+			sourceSchemaVersion: 1,
+			sourceJSON:          `{"id":"example"}`,
+			expectError:         true,
+		},
+		{
+			name:        "empty state",
+			move:        &StateMove{},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.move.SourceTypeName = "aws_source"
+			testCase.move.TargetTypeName = "aws_target"
+
+			got, err := testCase.move.Move(context.Background(), testCase.sourceSchemaVersion, []byte(testCase.sourceJSON), targetType)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if err == nil && !got.Equal(testCase.expected) {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAttributeAtPath(t *testing.T) {
+	t.Parallel()
+
+	state := map[string]any{
+		"bucket": "example",
+		"logging": []any{
+			map[string]any{
+				"target_bucket": "logs",
+			},
+		},
+	}
+
+	testCases := []struct {
+		path          string
+		expectedValue any
+		expectedOK    bool
+	}{
+		{path: "bucket", expectedValue: "example", expectedOK: true},
+		{path: "logging.0.target_bucket", expectedValue: "logs", expectedOK: true},
+		{path: "logging.1.target_bucket"},
+		{path: "logging.x"},
+		{path: "bucket.0"},
+		{path: "missing"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.path, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := AttributeAtPath(state, testCase.path)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("ok = %t, expected %t", ok, testCase.expectedOK)
+			}
+			if got != testCase.expectedValue {
+				t.Errorf("got %v, expected %v", got, testCase.expectedValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// WithImportByCompositeID is intended to be embedded in resources which import state via a composite identifier.
+// Each part of the identifier is imported into the top-level attribute with the part's name,
+// converting the value to a number or boolean if that is the attribute's type, and the "id" attribute,
+// if the resource has one and it is not itself a part, is set to the identifier.
+// Identifiers may also be a JSON object keyed by part name, for use in Terraform `import` blocks.
+// See https://developer.hashicorp.com/terraform/plugin/framework/resources/import.
+type WithImportByCompositeID struct {
+	compositeID compositeid.ID
+}
+
+// SetCompositeID sets the resource's composite identifier format.
+func (w *WithImportByCompositeID) SetCompositeID(id compositeid.ID) {
+	w.compositeID = id
+}
+
+// CompositeID returns the resource's composite identifier format.
+func (w *WithImportByCompositeID) CompositeID() compositeid.ID {
+	return w.compositeID
+}
+
+func (w *WithImportByCompositeID) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	values, err := w.compositeID.Parse(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	for i, part := range w.compositeID.Parts {
+		// Empty optional parts are left null.
+		if values[i] == "" {
+			continue
+		}
+
+		attrPath := path.Root(part.Name)
+
+		attrType, diags := response.State.Schema.TypeAtPath(ctx, attrPath)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v, err := importValue(attrType.TerraformType(ctx), values[i])
+		if err != nil {
+			response.Diagnostics.AddAttributeError(attrPath, "parsing resource ID", fmt.Sprintf("%s (%s): %s", part.Name, values[i], err))
+
+			return
+		}
+
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, attrPath, v)...)
+	}
+
+	if slices.Contains(w.compositeID.Names(), names.AttrID) {
+		return
+	}
+
+	if _, ok := response.State.Schema.GetAttributes()[names.AttrID]; ok {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), w.compositeID.Format(values...))...)
+	}
+}
+
+// importValue returns the Go value of the specified Terraform type for an identifier part's value.
+func importValue(typ tftypes.Type, s string) (any, error) {
+	switch {
+	case typ.Is(tftypes.Number):
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return v, nil
+		}
+
+		return strconv.ParseFloat(s, 64)
+	case typ.Is(tftypes.Bool):
+		return strconv.ParseBool(s)
+	default:
+		return s, nil
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+)
+
+func TestWithImportByCompositeIDImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"cluster":     schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+			"priority":    schema.Int64Attribute{Required: true},
+		},
+	}
+	stateType := resourceSchema.Type().TerraformType(ctx)
+
+	var w WithImportByCompositeID
+	w.SetCompositeID(compositeid.ID{
+		Parts: []compositeid.Part{
+			{Name: "cluster"},
+			{Name: "priority", Validators: []compositeid.Validator{compositeid.Int}},
+			{Name: "description", Optional: true},
+		},
+		Separator: "/",
+	})
+
+	testCases := []struct {
+		name        string
+		id          string
+		expected    tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "separated",
+			id:   "example/10/first",
+			expected: tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "example/10/first"),
+				"cluster":     tftypes.NewValue(tftypes.String, "example"),
+				"description": tftypes.NewValue(tftypes.String, "first"),
+				"priority":    tftypes.NewValue(tftypes.Number, 10),
+			}),
+		},
+		{
+			name: "JSON",
+			id:   `{"cluster":"example","priority":"10"}`,
+			expected: tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "example/10/"),
+				"cluster":     tftypes.NewValue(tftypes.String, "example"),
+				"description": tftypes.NewValue(tftypes.String, nil),
+				"priority":    tftypes.NewValue(tftypes.Number, 10),
+			}),
+		},
+		{
+			name:        "invalid",
+			id:          "example/high/first",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := resource.ImportStateRequest{
+				ID: testCase.id,
+			}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(stateType, nil),
+					Schema: resourceSchema,
+				},
+			}
+
+			w.ImportState(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Fatalf("HasError() = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if !response.State.Raw.Equal(testCase.expected) {
+				t.Errorf("state = %s, want %s", response.State.Raw, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestWithImportByCompositeIDImportStateIDPart(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":     schema.StringAttribute{Computed: true},
+			"parent": schema.StringAttribute{Required: true},
+		},
+	}
+	stateType := resourceSchema.Type().TerraformType(ctx)
+
+	var w WithImportByCompositeID
+	w.SetCompositeID(compositeid.ID{
+		Parts: []compositeid.Part{
+			{Name: "parent"},
+			{Name: "id"},
+		},
+	})
+
+	request := resource.ImportStateRequest{
+		ID: "example,child",
+	}
+	response := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(stateType, nil),
+			Schema: resourceSchema,
+		},
+	}
+
+	w.ImportState(ctx, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	expected := tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, "child"),
+		"parent": tftypes.NewValue(tftypes.String, "example"),
+	})
+
+	if !response.State.Raw.Equal(expected) {
+		t.Errorf("state = %s, want %s", response.State.Raw, expected)
+	}
+}
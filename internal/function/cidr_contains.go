@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_contains Function",
+		MarkdownDescription: "Checks whether a CIDR block contains an IP address or another CIDR block. " +
+			"IPv4 and IPv6 are supported.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "Containing CIDR block",
+			},
+			function.StringParameter{
+				Name:                "address_or_cidr",
+				MarkdownDescription: "IP address or CIDR block to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, addressOrCIDR string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &addressOrCIDR))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrContains(cidr, addressOrCIDR)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrContains returns whether the CIDR block contains the IP address or CIDR block.
+// Addresses and CIDR blocks of different IP versions are never contained.
+func cidrContains(cidr, addressOrCIDR string) (bool, error) {
+	prefix, err := parseCIDRBlock(cidr)
+	if err != nil {
+		return false, err
+	}
+
+	if strings.Contains(addressOrCIDR, "/") {
+		other, err := parseCIDRBlock(addressOrCIDR)
+		if err != nil {
+			return false, err
+		}
+
+		return other.Bits() >= prefix.Bits() && prefix.Contains(other.Addr()), nil
+	}
+
+	addr, err := netip.ParseAddr(addressOrCIDR)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid IP address: %w", addressOrCIDR, err)
+	}
+
+	return prefix.Contains(addr), nil
+}
+
+// parseCIDRBlock parses a valid CIDR block, i.e. one with no host bits set.
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	return prefix, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_overlaps Function",
+		MarkdownDescription: "Checks whether any of the CIDR blocks in a list overlap. " +
+			"Returns an error if any element is not a valid CIDR block.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidrs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "CIDR blocks to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrsOverlap(cidrs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrsOverlap returns whether any two of the CIDR blocks overlap.
+func cidrsOverlap(cidrs []string) (bool, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+
+	for i, cidr := range cidrs {
+		prefix, err := parseCIDRBlock(cidr)
+		if err != nil {
+			return false, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	for i, p1 := range prefixes {
+		for _, p2 := range prefixes[i+1:] {
+			if p1.Overlaps(p2) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDROverlapsFunction_overlapping(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDROverlapsFunctionConfig("10.0.0.0/16", "10.1.0.0/16", "10.0.128.0/17"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDROverlapsFunction_notOverlapping(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDROverlapsFunctionConfig("10.0.0.0/16", "10.1.0.0/16", "2001:db8::/56"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDROverlapsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDROverlapsFunctionConfig("10.0.0.0/16", "invalid"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDROverlapsFunctionConfig(cidrs ...string) string {
+	quoted := make([]string, len(cidrs))
+	for i, cidr := range cidrs {
+		quoted[i] = fmt.Sprintf("%q", cidr)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_overlaps([%[1]s])
+}
+`, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// maxSubnetPrefixLength is the longest prefix length allowed for an IPv4 subnet CIDR block
+	maxSubnetPrefixLength = 28
+)
+
+var cidrSubnetsPlanSubnetAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+var cidrSubnetsPlanResultAttrTypes = map[string]attr.Type{
+	"name":              types.StringType,
+	"availability_zone": types.StringType,
+	"cidr_block":        types.StringType,
+}
+
+var _ function.Function = cidrSubnetsPlanFunction{}
+
+func NewCIDRSubnetsPlanFunction() function.Function {
+	return &cidrSubnetsPlanFunction{}
+}
+
+type cidrSubnetsPlanFunction struct{}
+
+func (f cidrSubnetsPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_plan"
+}
+
+func (f cidrSubnetsPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_plan Function",
+		MarkdownDescription: "Plans the IPv4 CIDR blocks of named groups of subnets within a VPC's CIDR block. " +
+			"Each group has one subnet in each Availability Zone. Groups are allocated in order, each subnet " +
+			"aligned to its size, so adding a group to the end of the list does not change existing allocations.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zones to spread each group of subnets across",
+			},
+			function.ListParameter{
+				Name: "subnets",
+				ElementType: types.ObjectType{
+					AttrTypes: cidrSubnetsPlanSubnetAttrTypes,
+				},
+				MarkdownDescription: "Groups of subnets, each with a unique `name` and the `prefix_length` of its subnets",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: cidrSubnetsPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+type cidrSubnetsPlanSubnet struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+type plannedSubnet struct {
+	name             string
+	availabilityZone string
+	cidrBlock        string
+}
+
+func (f cidrSubnetsPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var availabilityZones []string
+	var subnets []cidrSubnetsPlanSubnet
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &availabilityZones, &subnets))
+	if resp.Error != nil {
+		return
+	}
+
+	plan, err := planSubnets(cidr, availabilityZones, subnets)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elements := make([]attr.Value, 0, len(plan))
+	for _, subnet := range plan {
+		value := map[string]attr.Value{
+			"name":              types.StringValue(subnet.name),
+			"availability_zone": types.StringValue(subnet.availabilityZone),
+			"cidr_block":        types.StringValue(subnet.cidrBlock),
+		}
+
+		element, d := types.ObjectValue(cidrSubnetsPlanResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		elements = append(elements, element)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: cidrSubnetsPlanResultAttrTypes}, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// planSubnets allocates one subnet per Availability Zone for each group of subnets, in order.
+// Each subnet is placed at the next free address aligned to its size.
+func planSubnets(cidr string, availabilityZones []string, subnets []cidrSubnetsPlanSubnet) ([]plannedSubnet, error) {
+	if err := verify.ValidateIPv4CIDRBlock(cidr); err != nil {
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	if len(availabilityZones) == 0 {
+		return nil, errors.New("at least one Availability Zone is required")
+	}
+
+	seen := make(map[string]bool)
+	for _, az := range availabilityZones {
+		if az == "" {
+			return nil, errors.New("empty Availability Zone")
+		}
+		if seen[az] {
+			return nil, fmt.Errorf("duplicate Availability Zone (%s)", az)
+		}
+		seen[az] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, subnet := range subnets {
+		if subnet.Name == "" {
+			return nil, errors.New("empty subnet name")
+		}
+		if seen[subnet.Name] {
+			return nil, fmt.Errorf("duplicate subnet name (%s)", subnet.Name)
+		}
+		seen[subnet.Name] = true
+
+		if subnet.PrefixLength < int64(prefix.Bits()) || subnet.PrefixLength > maxSubnetPrefixLength {
+			return nil, fmt.Errorf("subnet (%s) prefix_length (%d) must be between %d and %d", subnet.Name, subnet.PrefixLength, prefix.Bits(), maxSubnetPrefixLength)
+		}
+	}
+
+	b := prefix.Addr().As4()
+	start := uint64(binary.BigEndian.Uint32(b[:]))
+	end := start + uint64(1)<<(32-prefix.Bits())
+	next := start
+
+	plan := make([]plannedSubnet, 0, len(subnets)*len(availabilityZones))
+	for _, subnet := range subnets {
+		size := uint64(1) << (32 - subnet.PrefixLength)
+
+		for _, az := range availabilityZones {
+			// Align to the subnet's size.
+			if r := next % size; r != 0 {
+				next += size - r
+			}
+
+			if next+size > end {
+				return nil, fmt.Errorf("subnet (%s) in Availability Zone (%s) does not fit in %s", subnet.Name, az, cidr)
+			}
+
+			binary.BigEndian.PutUint32(b[:], uint32(next))
+			plan = append(plan, plannedSubnet{
+				name:             subnet.Name,
+				availabilityZone: az,
+				cidrBlock:        netip.PrefixFrom(netip.AddrFrom4(b), int(subnet.PrefixLength)).String(),
+			})
+
+			next += size
+		}
+	}
+
+	return plan, nil
+}
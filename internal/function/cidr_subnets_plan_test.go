@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsPlanFunctionConfig_basic("10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.16.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.32.0/20"),
+					resource.TestCheckOutput("database_a", "10.0.48.0/26"),
+					resource.TestCheckOutput("database_b", "10.0.48.64/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsPlanFunction_doesNotFit(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsPlanFunctionConfig_basic("10.0.0.0/20"),
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*fit`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsPlanFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsPlanFunctionConfig_basic("10.0.0.1/16"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv4[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsPlanFunctionConfig_basic(cidr string) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::cidr_subnets_plan(%[1]q, ["zone-a", "zone-b"], [
+    { name = "public", prefix_length = 24 },
+    { name = "private", prefix_length = 20 },
+    { name = "database", prefix_length = 26 },
+  ])
+
+  subnets = { for s in local.plan : "${s.name}_${substr(s.availability_zone, -1, 1)}" => s.cidr_block }
+}
+
+output "public_a" {
+  value = local.subnets["public_a"]
+}
+
+output "public_b" {
+  value = local.subnets["public_b"]
+}
+
+output "private_a" {
+  value = local.subnets["private_a"]
+}
+
+output "private_b" {
+  value = local.subnets["private_b"]
+}
+
+output "database_a" {
+  value = local.subnets["database_a"]
+}
+
+output "database_b" {
+  value = local.subnets["database_b"]
+}
+`, cidr)
+}
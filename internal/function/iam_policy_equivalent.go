@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEquivalentFunction{}
+
+func NewIAMPolicyEquivalentFunction() function.Function {
+	return &iamPolicyEquivalentFunction{}
+}
+
+type iamPolicyEquivalentFunction struct{}
+
+func (f iamPolicyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equivalent"
+}
+
+func (f iamPolicyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equivalent Function",
+		MarkdownDescription: "Checks whether two IAM policy documents are equivalent. Differences in formatting, " +
+			"element ordering and single-element arrays versus scalar values are ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy1",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "policy2",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy1, policy2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy1, &policy2))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, policy := range []string{policy1, policy2} {
+		if err := validPolicyJSON(policy); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(policy1, policy2)))
+}
+
+// validPolicyJSON returns an error if the specified policy document is neither empty nor a JSON object.
+func validPolicyJSON(policy string) error {
+	if strings.TrimSpace(policy) == "" {
+		return nil
+	}
+
+	var v map[string]any
+	if err := json.Unmarshal([]byte(policy), &v); err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	return nil
+}
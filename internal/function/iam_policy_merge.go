@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// policyVersion is the current version of the IAM policy language
+	policyVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single normalized policy document containing the " +
+			"union of their statements. Equivalent statements are included once. Statements with the same Sid " +
+			"must be equivalent.",
+		VariadicParameter: function.StringParameter{
+			Name:                "policies",
+			MarkdownDescription: "IAM policy documents in JSON format",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type policyDocument struct {
+	Version   string          `json:",omitempty"`
+	ID        string          `json:"Id,omitempty"`
+	Statement json.RawMessage `json:",omitempty"`
+}
+
+type mergedPolicyDocument struct {
+	Version   string `json:",omitempty"`
+	ID        string `json:"Id,omitempty"`
+	Statement []map[string]any
+}
+
+// mergePolicies returns the normalized union of the statements in the specified policy documents.
+// Empty policy documents are ignored.
+func mergePolicies(policies []string) (string, error) {
+	merged := mergedPolicyDocument{
+		Statement: make([]map[string]any, 0),
+	}
+	sids := make(map[string]int)
+
+	for i, policy := range policies {
+		if v := strings.TrimSpace(policy); v == "" || v == "{}" {
+			continue
+		}
+
+		var doc policyDocument
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return "", fmt.Errorf("policy %d (%s) is invalid JSON: %w", i, policy, err)
+		}
+
+		statements, err := policyStatements(doc.Statement)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		// The newer policy language version wins.
+		if doc.Version > merged.Version {
+			merged.Version = doc.Version
+		}
+		if merged.ID == "" {
+			merged.ID = doc.ID
+		}
+
+	statements:
+		for _, statement := range statements {
+			sid, _ := statement["Sid"].(string)
+
+			if sid != "" {
+				if j, ok := sids[sid]; ok {
+					if !statementsEquivalent(merged.Statement[j], statement) {
+						return "", fmt.Errorf("policy %d: statement Sid (%s) conflicts with a different statement with the same Sid", i, sid)
+					}
+					continue
+				}
+			}
+
+			if sid == "" {
+				for _, existing := range merged.Statement {
+					if statementsEquivalent(existing, statement) {
+						continue statements
+					}
+				}
+			} else {
+				sids[sid] = len(merged.Statement)
+			}
+			merged.Statement = append(merged.Statement, statement)
+		}
+	}
+
+	if merged.Version == "" {
+		merged.Version = policyVersion
+	}
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(string(b))
+}
+
+// policyStatements returns a policy document's Statement element, which is either a single statement or an array of statements.
+func policyStatements(raw json.RawMessage) ([]map[string]any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var statements []map[string]any
+	if err := json.Unmarshal(raw, &statements); err == nil {
+		return statements, nil
+	}
+
+	var statement map[string]any
+	if err := json.Unmarshal(raw, &statement); err != nil {
+		return nil, fmt.Errorf("invalid Statement element, expected a statement or an array of statements: %w", err)
+	}
+
+	return []map[string]any{statement}, nil
+}
+
+// statementsEquivalent returns whether two policy statements are equivalent.
+func statementsEquivalent(s1, s2 map[string]any) bool {
+	p1, err := json.Marshal(mergedPolicyDocument{Version: policyVersion, Statement: []map[string]any{s1}})
+	if err != nil {
+		return false
+	}
+
+	p2, err := json.Marshal(mergedPolicyDocument{Version: policyVersion, Statement: []map[string]any{s2}})
+	if err != nil {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(string(p1), string(p2))
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+		`{"Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+		``,
+		`{"Statement":[{"Effect":"Deny","Action":["s3:DeleteObject"],"Resource":["*"]}]}`,
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:DeleteObject","Effect":"Deny","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(args...),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_sidConflict(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+		`{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}}`,
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(args...),
+				ExpectError: regexache.MustCompile(`statement[\s\n]*Sid[\s\n]*\(Read\)[\s\n]*conflicts`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Statement":`,
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(args...),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = fmt.Sprintf("%q", arg)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge(%[1]s)
+}
+`, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_valid(t *testing.T) {
+	t.Parallel()
+	arg := `{"Statement":[{"Resource":"*","Effect":"Allow","Action":"s3:GetObject"}],  "Version": "2012-10-17"}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+	arg := `{"Statement":`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig(arg),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_normalize(%[1]q)
+}
+`, arg)
+}
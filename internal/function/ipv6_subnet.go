@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// ipv6SubnetPrefixLength is the prefix length of IPv6 CIDR blocks associated with subnets
+	ipv6SubnetPrefixLength = 64
+)
+
+var _ function.Function = ipv6SubnetFunction{}
+
+func NewIPv6SubnetFunction() function.Function {
+	return &ipv6SubnetFunction{}
+}
+
+type ipv6SubnetFunction struct{}
+
+func (f ipv6SubnetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_subnet"
+}
+
+func (f ipv6SubnetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ipv6_subnet Function",
+		MarkdownDescription: "Calculates the /64 IPv6 CIDR block of a subnet within a VPC's IPv6 CIDR block. " +
+			"Subnets are numbered from 0.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv6 CIDR block of the VPC, e.g. a /56",
+			},
+			function.Int64Parameter{
+				Name:                "netnum",
+				MarkdownDescription: "Subnet number",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ipv6SubnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var netnum int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &netnum))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := ipv6Subnet(cidr, netnum)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ipv6Subnet returns the netnum'th /64 CIDR block within the specified IPv6 CIDR block.
+func ipv6Subnet(cidr string, netnum int64) (string, error) {
+	if err := verify.ValidateIPv6CIDRBlock(cidr); err != nil {
+		return "", err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	if prefix.Bits() > ipv6SubnetPrefixLength {
+		return "", fmt.Errorf("%q prefix length must be at most /%d", cidr, ipv6SubnetPrefixLength)
+	}
+
+	newbits := ipv6SubnetPrefixLength - prefix.Bits()
+	if netnum < 0 || (newbits < 63 && netnum >= int64(1)<<newbits) {
+		return "", fmt.Errorf("netnum (%d) must be between 0 and %d for %q", netnum, uint64(1)<<newbits-1, cidr)
+	}
+
+	// The subnet number occupies the low-order bits of the 64-bit network prefix.
+	b := prefix.Addr().As16()
+	binary.BigEndian.PutUint64(b[:8], binary.BigEndian.Uint64(b[:8])|uint64(netnum))
+
+	return netip.PrefixFrom(netip.AddrFrom16(b), ipv6SubnetPrefixLength).String(), nil
+}
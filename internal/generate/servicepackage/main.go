@@ -16,6 +16,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -108,6 +109,8 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	RegionAnnotated         bool
+	RegionOverrideEnabled   bool
 }
 
 type ServiceDatum struct {
@@ -185,12 +188,27 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and Region annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			d.RegionAnnotated = true
+			d.RegionOverrideEnabled = true
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if enabled, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.RegionOverrideEnabled = enabled
+				}
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -261,7 +279,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
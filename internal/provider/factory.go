@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
 
@@ -23,8 +24,21 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
+	// Terraform Plugin SDK doesn't support moving resource state across resource types.
+	// Handle moves to resources implemented using the SDK before their requests reach the primary provider server.
+	var moves []*framework.StateMove
+	for _, sp := range servicePackages(ctx) {
+		if v, ok := sp.(interface {
+			StateMoves(context.Context) []*framework.StateMove
+		}); ok {
+			moves = append(moves, v.StateMoves(ctx)...)
+		}
+	}
+
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return framework.NewStateMoverProviderServer(primary.GRPCProvider(), moves)
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -8,16 +8,22 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tftracing "github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -200,13 +206,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regional is true if the data source has the per-resource `region` argument.
+	regional bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regional bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regional:         regional,
 	}
 }
 
@@ -218,11 +227,20 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]datasourceschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			w.inner.Read(ctx, request, response)
+		}, (*tfsdk.State)(&request.Config), &response.State)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -248,6 +266,26 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// regionDataSourceInterceptor implements the per-resource `region` argument for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		var d diag.Diagnostics
+		ctx, d = newRegionContext(ctx, request.Config)
+		diags.Append(d...)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.ResourceRegion(ctx))...)
+	}
+
+	return ctx, diags
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -255,13 +293,16 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regional is true if the resource has the per-resource `region` argument.
+	regional bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regional bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regional:         regional,
 	}
 }
 
@@ -273,11 +314,20 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]resourceschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionResourceAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			w.inner.Create(ctx, request, response)
+		}, (*tfsdk.State)(&request.Config), (*tfsdk.State)(&request.Plan), &response.State)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -287,7 +337,9 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			w.inner.Read(ctx, request, response)
+		}, &request.State, &response.State)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -297,7 +349,9 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			w.inner.Update(ctx, request, response)
+		}, (*tfsdk.State)(&request.Config), (*tfsdk.State)(&request.Plan), &request.State, &response.State)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -307,7 +361,9 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			w.inner.Delete(ctx, request, response)
+		}, &request.State, &response.State)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -326,7 +382,23 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+
+		// Import IDs of the form `<id>@<region>` import the resource from the specified Region.
+		var region string
+		if w.regional {
+			request.ID, region = conns.SplitImportIDRegion(request.ID)
+			if region != "" {
+				ctx = conns.NewRegionContext(ctx, region)
+			}
+		}
+
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			v.ImportState(ctx, request, response)
+		}, &response.State)
+
+		if region != "" && !response.Diagnostics.HasError() {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		}
 
 		return
 	}
@@ -338,16 +410,54 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	// The region context is set up whether or not the inner resource implements ModifyPlan.
+	regional := w.regional && !request.Plan.Raw.IsNull()
+	if regional {
+		var diags diag.Diagnostics
+		ctx, diags = newRegionContext(ctx, request.Plan)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			v.ModifyPlan(ctx, request, response)
+		}, (*tfsdk.State)(&request.Config), (*tfsdk.State)(&request.Plan), &request.State, (*tfsdk.State)(&response.Plan))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Plan the provider's configured Region for new resources that don't configure one.
+	if regional && w.meta != nil && !response.Plan.Raw.IsNull() {
+		var region fwtypes.String
+		response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if region.IsUnknown() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.ResourceRegion(ctx))...)
+		}
 	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	if v, ok := w.inner.(resource.ResourceWithConfigValidators); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		return v.ConfigValidators(ctx)
+		validators := v.ConfigValidators(ctx)
+
+		if w.regional {
+			validators = slices.ApplyToAll(validators, func(v resource.ConfigValidator) resource.ConfigValidator {
+				return regionlessConfigValidator{ConfigValidator: v}
+			})
+		}
+
+		return validators
 	}
 
 	return nil
@@ -356,15 +466,25 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+		withoutRegion(ctx, w.regional, &response.Diagnostics, func() {
+			v.ValidateConfig(ctx, request, response)
+		}, (*tfsdk.State)(&request.Config))
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if w.regional {
+			for version, upgrader := range upgraders {
+				upgrader.StateUpgrader = regionlessStateUpgrader(upgrader.StateUpgrader)
+				upgraders[version] = upgrader
+			}
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
@@ -373,13 +493,73 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		movers := v.MoveState(ctx)
+
+		if w.regional {
+			for i, mover := range movers {
+				mover.StateMover = regionlessStateMover(mover.StateMover)
+				movers[i] = mover
+			}
+		}
 
-		return v.MoveState(ctx)
+		return movers
 	}
 
 	return nil
 }
 
+// regionlessConfigValidator validates a regional resource's configuration without the per-resource `region` attribute.
+type regionlessConfigValidator struct {
+	resource.ConfigValidator
+}
+
+func (v regionlessConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	withoutRegion(ctx, true, &response.Diagnostics, func() {
+		v.ConfigValidator.ValidateResource(ctx, request, response)
+	}, (*tfsdk.State)(&request.Config))
+}
+
+// regionlessStateUpgrader upgrades a regional resource's state without the per-resource `region` attribute.
+// The prior state's `region` value is preserved.
+func regionlessStateUpgrader(f func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse)) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+		withoutRegion(ctx, true, &response.Diagnostics, func() {
+			f(ctx, request, response)
+		}, &response.State)
+
+		setRegionFromRawState(ctx, request.RawState, &response.State, &response.Diagnostics)
+	}
+}
+
+// regionlessStateMover moves another resource's state to a regional resource without the per-resource `region` attribute.
+// The source state's `region` value, if any, is preserved.
+func regionlessStateMover(f func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse)) func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse) {
+	return func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+		withoutRegion(ctx, true, &response.Diagnostics, func() {
+			f(ctx, request, response)
+		}, &response.TargetState)
+
+		setRegionFromRawState(ctx, request.SourceRawState, &response.TargetState, &response.Diagnostics)
+	}
+}
+
+// setRegionFromRawState sets the `region` attribute in state from a raw prior or source state.
+func setRegionFromRawState(ctx context.Context, rawState *tfprotov6.RawState, state *tfsdk.State, diags *diag.Diagnostics) {
+	if diags.HasError() || state.Raw.IsNull() {
+		return
+	}
+
+	region, err := rawStateRegion(rawState)
+	if err != nil {
+		diags.AddError("Reading region from raw state", err.Error())
+		return
+	}
+
+	if region != "" {
+		diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+	}
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -653,3 +833,226 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// regionResourceInterceptor implements the per-resource `region` argument for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan, meta, diags)
+	case After:
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State, meta, diags)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan, meta, diags)
+	case After:
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		return r.before(ctx, request.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// before sets the AWS Region used by the CRUD handler's AWS API clients to the resource's configured Region.
+func (r regionResourceInterceptor) before(ctx context.Context, getter attributeGetter, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	var d diag.Diagnostics
+	ctx, d = newRegionContext(ctx, getter)
+	diags.Append(d...)
+
+	return ctx, diags
+}
+
+// after sets region in state after CRU.
+func (r regionResourceInterceptor) after(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.ResourceRegion(ctx))...)
+
+	return ctx, diags
+}
+
+// defaultTimeoutsResourceInterceptor makes any provider configured default timeouts for the resource type
+// available to CRUD operations on resources.
+type defaultTimeoutsResourceInterceptor struct {
+	typeName string
+}
+
+func (r defaultTimeoutsResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r defaultTimeoutsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r defaultTimeoutsResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r defaultTimeoutsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r defaultTimeoutsResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	if when == Before {
+		ctx = meta.NewDefaultTimeoutsContext(ctx, r.typeName)
+	}
+
+	return ctx, diags
+}
+
+// readCacheResourceInterceptor scopes the provider's read cache to Read operations on resources.
+// Create, Update and Delete operations invalidate all cached reads for the resource's service package.
+type readCacheResourceInterceptor struct {
+	servicePackageName string
+}
+
+func (r readCacheResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.invalidate(ctx, meta, when, diags)
+}
+
+func (r readCacheResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	if when == Before {
+		ctx = meta.NewReadCacheContext(ctx)
+	}
+
+	return ctx, diags
+}
+
+func (r readCacheResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.invalidate(ctx, meta, when, diags)
+}
+
+func (r readCacheResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.invalidate(ctx, meta, when, diags)
+}
+
+func (r readCacheResourceInterceptor) invalidate(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before, Finally:
+		meta.InvalidateReadCache(r.servicePackageName)
+	}
+
+	return ctx, diags
+}
+
+// concurrencyLimitResourceInterceptor limits the number of concurrent CRUD operations on resources.
+// Operations in excess of any provider configured concurrency limits are queued.
+type concurrencyLimitResourceInterceptor struct {
+	servicePackageName string
+	typeName           string
+}
+
+func (r concurrencyLimitResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r concurrencyLimitResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r concurrencyLimitResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r concurrencyLimitResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r concurrencyLimitResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if err := meta.WaitConcurrencyLimits(ctx, r.servicePackageName, r.typeName); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for %s concurrency limit", r.typeName), err.Error())
+		}
+	case Finally:
+		meta.NotifyConcurrencyLimits(r.servicePackageName, r.typeName)
+	}
+
+	return ctx, diags
+}
+
+// tracingResourceInterceptor wraps CRUD operations on resources in OpenTelemetry spans.
+type tracingResourceInterceptor struct {
+	servicePackageName string
+	typeName           string
+}
+
+func (r tracingResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Create", when, diags)
+}
+
+func (r tracingResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Read", when, diags)
+}
+
+func (r tracingResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Update", when, diags)
+}
+
+func (r tracingResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Delete", when, diags)
+}
+
+func (r tracingResourceInterceptor) run(ctx context.Context, operation string, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = tftracing.StartOperation(ctx, r.servicePackageName, r.typeName, operation)
+	case Finally:
+		tftracing.EndOperation(ctx, fwdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
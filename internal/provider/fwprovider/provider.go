@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -68,6 +69,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"emulator_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Configure the provider for use with a local AWS emulator. Skips credentials validation, requesting the account ID, region validation and the EC2 metadata service.",
+			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base endpoint URL used for all AWS services. Can also be configured using the `AWS_ENDPOINT_URL` environment variable. Per-service `endpoints` take precedence.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -96,10 +105,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to cache and coalesce reads of shared parent objects, e.g. security groups, route tables and hosted zone record sets, during refresh. Reduces API calls and throttling when many resources share a parent.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"retry_budget": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether resource retry loops use jittered exponential backoff, a retry budget shared by all resources and a circuit breaker for each AWS service. Limits retries when AWS APIs are being throttled.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -167,9 +184,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "Ordered list of IAM Roles to assume prior to making API calls. Each role is assumed using the credentials obtained from the previous one.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -252,6 +267,26 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"concurrency_limits": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to limit the number of concurrent CRUD operations. Operations in excess of a limit are queued.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.MapAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Description: "Maximum number of concurrent operations, keyed by resource type, e.g. `aws_route53_record`.",
+						},
+						"service_packages": schema.MapAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Description: "Maximum number of concurrent operations across all of a service's resource types, keyed by service package name, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -267,6 +302,33 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for resource types. Timeouts configured in a resource's `timeouts` block take precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Create timeout, e.g. `90m`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Delete timeout, e.g. `90m`.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Read timeout, e.g. `90m`.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource type, e.g. `aws_db_instance`, or glob pattern, e.g. `aws_rds_*`, that the timeouts apply to.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Update timeout, e.g. `90m`.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -280,12 +342,76 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key patterns to ignore across all resources. " +
+								"Patterns are globs (`*` and `?` wildcards) or, if enclosed in `/`, regular expressions.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tag keys to ignore across all resources.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"tag_patterns": schema.ListNestedBlock{
+							Description: "Resource tag key and value patterns to ignore across all resources.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Optional:    true,
+										Description: "Resource tag key pattern. If omitted, all keys match.",
+									},
+									"value": schema.StringAttribute{
+										Optional:    true,
+										Description: "Resource tag value pattern. If omitted, all values match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a tag policy to enforce at plan time across all taggable resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "How tag policy violations are reported. Valid values are `error` and `warning`. Defaults to `warning`.",
+						},
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Casing rule for resource tag keys. Valid values are `camel`, `kebab`, `lower`, `pascal`, `snake` and `upper`.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be present on all taggable resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"allowed_values": schema.SetNestedBlock{
+							Description: "Allowed values for resource tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Allowed values for the resource tag key.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -337,11 +463,25 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
+					ctx = tfretry.NewContext(ctx, meta.RetryEngine(ctx), servicePackageName)
 				}
 
 				return ctx
 			}
 			interceptors := dataSourceInterceptors{}
+			regional := false
+
+			if isRegionOverrideEnabled(servicePackageName, v.Region) {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				// A data source's own top-level `region` attribute takes precedence.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regional = true
+					// The region interceptor must be first so that all subsequent interceptors use the data source's Region.
+					interceptors = append(interceptors, regionDataSourceInterceptor{})
+				}
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -363,7 +503,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, regional)
 			})
 		}
 	}
@@ -408,11 +548,25 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
+					ctx = tfretry.NewContext(ctx, meta.RetryEngine(ctx), servicePackageName)
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			regional := false
+
+			if isRegionOverrideEnabled(servicePackageName, v.Region) {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				// A resource's own top-level `region` attribute takes precedence.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regional = true
+					// The region interceptor must be first so that all subsequent interceptors use the resource's Region.
+					interceptors = append(interceptors, regionResourceInterceptor{})
+				}
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -442,8 +596,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			interceptors = append(interceptors, defaultTimeoutsResourceInterceptor{
+				typeName: typeName,
+			})
+			interceptors = append(interceptors, readCacheResourceInterceptor{
+				servicePackageName: servicePackageName,
+			})
+
+			// The concurrency limit and tracing interceptors must be last so that once run
+			// no subsequent Before interceptor can short circuit their Finally.
+			interceptors = append(interceptors, concurrencyLimitResourceInterceptor{
+				servicePackageName: servicePackageName,
+				typeName:           typeName,
+			})
+			interceptors = append(interceptors, tracingResourceInterceptor{
+				servicePackageName: servicePackageName,
+				typeName:           typeName,
+			})
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, regional)
 			})
 		}
 	}
@@ -466,6 +638,13 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSubnetsPlanFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewIPv6SubnetFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
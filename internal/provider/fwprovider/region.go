@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether a resource or data source has the per-resource `region` argument.
+// All resources and data sources in regional services have the argument unless they opt out using `@Region(overrideEnabled=false)`.
+// The `region` attribute is hidden from the resource or data source's own Config, Plan and State,
+// so its model does not declare the attribute, and its CRUD handlers obtain the AWS Region from the Context.
+func isRegionOverrideEnabled(servicePackageName string, region *types.ServicePackageResourceRegion) bool {
+	return !names.IsGlobalService(servicePackageName) && (region == nil || region.IsOverrideEnabled)
+}
+
+// regionDataSourceAttribute returns the schema for a regional data source's `region` argument.
+func regionDataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region to read the data source from. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceAttribute returns the schema for a regional resource's `region` argument.
+func regionResourceAttribute() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region to manage the resource in. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// attributeGetter is implemented by Config, Plan and State.
+type attributeGetter interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
+
+// newRegionContext returns a Context with the per-resource AWS Region override from the `region` attribute's value, if set.
+func newRegionContext(ctx context.Context, getter attributeGetter) (context.Context, diag.Diagnostics) {
+	var region fwtypes.String
+	diags := getter.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		ctx = conns.NewRegionContext(ctx, v)
+	}
+
+	return ctx, diags
+}
+
+// withoutRegion calls f with the per-resource `region` attribute removed from the specified Config, Plan or State values' schema and value.
+// The attribute is restored, with its original value, after f returns.
+func withoutRegion(ctx context.Context, regional bool, diags *diag.Diagnostics, f func(), values ...*tfsdk.State) {
+	if !regional {
+		f()
+		return
+	}
+
+	restorers := make([]func() error, 0, len(values))
+	for _, v := range values {
+		restore, err := removeRegion(ctx, v)
+		if err != nil {
+			diags.AddError("Removing region attribute", err.Error())
+			return
+		}
+		restorers = append(restorers, restore)
+	}
+
+	f()
+
+	for _, restore := range restorers {
+		if err := restore(); err != nil {
+			diags.AddError("Restoring region attribute", err.Error())
+		}
+	}
+}
+
+// removeRegion removes the `region` attribute from the specified value's schema and value
+// and returns a function that restores the attribute.
+func removeRegion(ctx context.Context, v *tfsdk.State) (func() error, error) {
+	if v.Schema == nil {
+		return func() error { return nil }, nil
+	}
+
+	outerSchema, outerType := v.Schema, v.Schema.Type().TerraformType(ctx)
+	switch schema := v.Schema.(type) {
+	case resourceschema.Schema:
+		schema.Attributes = maps.Clone(schema.Attributes)
+		delete(schema.Attributes, names.AttrRegion)
+		v.Schema = schema
+	case datasourceschema.Schema:
+		schema.Attributes = maps.Clone(schema.Attributes)
+		delete(schema.Attributes, names.AttrRegion)
+		v.Schema = schema
+	default:
+		return nil, fmt.Errorf("unexpected schema type: %T", schema)
+	}
+	innerType := v.Schema.Type().TerraformType(ctx)
+
+	region, err := regionValue(v.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := transformObjectValue(v.Raw, innerType, func(attributes map[string]tftypes.Value) {
+		delete(attributes, names.AttrRegion)
+	})
+	if err != nil {
+		return nil, err
+	}
+	v.Raw = raw
+
+	return func() error {
+		raw, err := transformObjectValue(v.Raw, outerType, func(attributes map[string]tftypes.Value) {
+			attributes[names.AttrRegion] = region
+		})
+		if err != nil {
+			return err
+		}
+		v.Schema, v.Raw = outerSchema, raw
+
+		return nil
+	}, nil
+}
+
+// regionValue returns the value of the specified object value's `region` attribute.
+func regionValue(v tftypes.Value) (tftypes.Value, error) {
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	if v.IsNull() || !v.IsKnown() {
+		return null, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return null, err
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		return v, nil
+	}
+
+	return null, nil
+}
+
+// transformObjectValue returns a copy of the specified object value, of the specified type, with its attributes transformed.
+// Null and unknown values are returned as null and unknown values of the specified type.
+func transformObjectValue(v tftypes.Value, typ tftypes.Type, f func(map[string]tftypes.Value)) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	f(attributes)
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// rawStateRegion returns the `region` attribute's value from a resource's raw prior state.
+func rawStateRegion(rawState *tfprotov6.RawState) (string, error) {
+	if rawState == nil || len(rawState.JSON) == 0 {
+		return "", nil
+	}
+
+	var v struct {
+		Region string `json:"region"`
+	}
+	if err := json.Unmarshal(rawState.JSON, &v); err != nil {
+		return "", err
+	}
+
+	return v.Region, nil
+}
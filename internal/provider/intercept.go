@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	tftracing "github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -79,6 +81,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return fmt.Sprintf("why(%d)", w)
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -153,6 +170,8 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regional is true if the resource has the per-resource `region` argument.
+	regional bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -175,6 +194,17 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regional {
+			// Import IDs of the form `<id>@<region>` import the resource from the specified Region.
+			if id, region := conns.SplitImportIDRegion(d.Id()); region != "" {
+				d.SetId(id)
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+				ctx = conns.NewRegionContext(ctx, region)
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -183,6 +213,12 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regional {
+			if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+				ctx = conns.NewRegionContext(ctx, v)
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -245,6 +281,13 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 			tagsInContext.TagsIn = option.Some(tags)
 
+			// Enforced tag policy violations are normally reported at plan time, but tags may not have been known then.
+			if policyConfig := meta.(*conns.AWSClient).TagPolicyConfig; policyConfig.IsError() {
+				if policyDiags := tagPolicyDiags(policyConfig, tags.IgnoreConfig(tagsInContext.IgnoreConfig)); policyDiags.HasError() {
+					return ctx, append(diags, policyDiags...)
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -379,6 +422,16 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 				ctx, diags = r.readFunc(ctx, d, sp, r.tags, serviceName, resourceName, meta, diags)
 			}
 		}
+
+		switch why {
+		case Create, Update:
+			// Plugin SDK V2 CustomizeDiff functions can't return warnings, so unenforced tag policy violations are reported here.
+			if policyConfig := meta.(*conns.AWSClient).TagPolicyConfig; !policyConfig.IsError() {
+				if tagsInContext.TagsIn.IsSome() {
+					diags = append(diags, tagPolicyDiags(policyConfig, tagsInContext.TagsIn.MustUnwrap().IgnoreConfig(tagsInContext.IgnoreConfig))...)
+				}
+			}
+		}
 	}
 
 	return ctx, diags
@@ -454,3 +507,107 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// regionInterceptor implements the per-resource `region` argument for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// AWS API clients used by the CRUD handler are for the resource's configured Region.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			ctx = conns.NewRegionContext(ctx, v)
+		}
+	case After:
+		// Set region in state after CRU.
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, c.ResourceRegion(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// readCacheResourceInterceptor scopes the provider's read cache to Read operations on resources.
+// Create, Update and Delete operations invalidate all cached reads for the resource's service package.
+type readCacheResourceInterceptor struct {
+	servicePackageName string
+}
+
+func (r readCacheResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch why {
+	case Read:
+		if when == Before {
+			ctx = c.NewReadCacheContext(ctx)
+		}
+	default:
+		switch when {
+		case Before, Finally:
+			c.InvalidateReadCache(r.servicePackageName)
+		}
+	}
+
+	return ctx, diags
+}
+
+// concurrencyLimitResourceInterceptor limits the number of concurrent CRUD operations on resources.
+// Operations in excess of any provider configured concurrency limits are queued.
+type concurrencyLimitResourceInterceptor struct {
+	servicePackageName string
+	typeName           string
+}
+
+func (r concurrencyLimitResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if err := c.WaitConcurrencyLimits(ctx, r.servicePackageName, r.typeName); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "waiting for %s concurrency limit: %s", r.typeName, err)
+		}
+	case Finally:
+		c.NotifyConcurrencyLimits(r.servicePackageName, r.typeName)
+	}
+
+	return ctx, diags
+}
+
+// tracingResourceInterceptor wraps CRUD operations on resources in OpenTelemetry spans.
+type tracingResourceInterceptor struct {
+	servicePackageName string
+	typeName           string
+}
+
+func (r tracingResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = tftracing.StartOperation(ctx, r.servicePackageName, r.typeName, why.String())
+	case Finally:
+		tftracing.EndOperation(ctx, sdkdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,9 +22,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -51,6 +54,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"concurrency_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to limit the number of concurrent CRUD operations. Operations in excess of a limit are queued.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Maximum number of concurrent operations, keyed by resource type, e.g. `aws_route53_record`.",
+						},
+						"service_packages": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Maximum number of concurrent operations across all of a service's resource types, keyed by service package name, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -74,6 +99,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with default operation timeouts for resource types. Timeouts configured in a resource's `timeouts` block take precedence.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default Create timeout, e.g. `90m`.",
+							ValidateFunc: validDefaultTimeout,
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default Delete timeout, e.g. `90m`.",
+							ValidateFunc: validDefaultTimeout,
+						},
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default Read timeout, e.g. `90m`.",
+							ValidateFunc: validDefaultTimeout,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource type, e.g. `aws_db_instance`, or glob pattern, e.g. `aws_rds_*`, that the timeouts apply to.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default Update timeout, e.g. `90m`.",
+							ValidateFunc: validDefaultTimeout,
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -86,6 +149,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"emulator_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Configure the provider for use with a local AWS emulator. " +
+					"Skips credentials validation, requesting the account ID, region validation and the EC2 metadata service.",
+			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Base endpoint URL used for all AWS services. " +
+					"Can also be configured using the `AWS_ENDPOINT_URL` environment variable. Per-service `endpoints` take precedence.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -124,6 +199,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validIgnoreTagsPattern,
+							},
+							Description: "Resource tag key patterns to ignore across all resources. " +
+								"Patterns are globs (`*` and `?` wildcards) or, if enclosed in `/`, regular expressions.",
+						},
+						"tag_patterns": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tag key and value patterns to ignore across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validIgnoreTagsPattern,
+										Description:  "Resource tag key pattern. If omitted, all keys match.",
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validIgnoreTagsPattern,
+										Description:  "Resource tag value pattern. If omitted, all values match.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -152,12 +258,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_cache": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to cache and coalesce reads of shared parent objects, " +
+					"e.g. security groups, route tables and hosted zone record sets, during refresh. " +
+					"Reduces API calls and throttling when many resources share a parent.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry_budget": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether resource retry loops use jittered exponential backoff, " +
+					"a retry budget shared by all resources and a circuit breaker for each AWS service. " +
+					"Limits retries when AWS APIs are being throttled.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -228,6 +348,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a tag policy to enforce at plan time across all taggable resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Allowed values for resource tag keys.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Allowed values for the resource tag key.",
+									},
+								},
+							},
+						},
+						"enforcement": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.PolicyEnforcement](),
+							Description: "How tag policy violations are reported. " +
+								"Valid values are `error` and `warning`. Defaults to `warning`.",
+						},
+						"key_case": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.KeyCase](),
+							Description: "Casing rule for resource tag keys. " +
+								"Valid values are `camel`, `kebab`, `lower`, `pascal`, `snake` and `upper`.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present on all taggable resources.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -292,12 +462,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
+					ctx = tfretry.NewContext(ctx, v.RetryEngine(ctx), servicePackageName)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
 
+			if isRegionOverrideEnabled(servicePackageName, v.Region) && injectRegionAttribute(r, regionDataSourceSchema()) {
+				// The region interceptor must be first so that all subsequent interceptors use the data source's Region.
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -369,11 +549,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
+					ctx = tfretry.NewContext(ctx, v.RetryEngine(ctx), servicePackageName)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+			regional := isRegionOverrideEnabled(servicePackageName, v.Region) && injectRegionAttribute(r, regionResourceSchema())
+
+			if regional {
+				// The region interceptor must be first so that all subsequent interceptors use the resource's Region.
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -410,9 +601,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			interceptors = append(interceptors, interceptorItem{
+				when: Before | Finally,
+				why:  AllOps,
+				interceptor: readCacheResourceInterceptor{
+					servicePackageName: servicePackageName,
+				},
+			})
+
+			// The concurrency limit and tracing interceptors must be last so that once run
+			// no subsequent Before interceptor can short circuit their Finally.
+			interceptors = append(interceptors, interceptorItem{
+				when: Before | Finally,
+				why:  AllOps,
+				interceptor: concurrencyLimitResourceInterceptor{
+					servicePackageName: servicePackageName,
+					typeName:           typeName,
+				},
+			})
+			interceptors = append(interceptors, interceptorItem{
+				when: Before | Finally,
+				why:  AllOps,
+				interceptor: tracingResourceInterceptor{
+					servicePackageName: servicePackageName,
+					typeName:           typeName,
+				},
+			})
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				regional:         regional,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -480,11 +699,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EmulatorMode:                   d.Get("emulator_mode").(bool),
+		EndpointURL:                    d.Get("endpoint_url").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadCache:                      d.Get("read_cache").(bool),
 		Region:                         d.Get("region").(string),
+		RetryBudget:                    d.Get("retry_budget").(bool),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -514,14 +737,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		config.AssumeRole = expandAssumeRoles(ctx, v.([]interface{}))
+		for i, assumeRole := range config.AssumeRole {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -532,10 +758,27 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("concurrency_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		servicePackageLimits, resourceTypeLimits, err := expandConcurrencyLimits(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServicePackageConcurrencyLimits = servicePackageLimits
+		config.ResourceTypeConcurrencyLimits = resourceTypeLimits
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		defaultTimeouts, err := expandDefaultTimeouts(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.DefaultTimeouts = defaultTimeouts
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -544,6 +787,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if config.EndpointURL == "" {
+		config.EndpointURL = os.Getenv("AWS_ENDPOINT_URL")
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -564,7 +811,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, err := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.IgnoreTagsConfig = ignoreTagsConfig
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.TagPolicyConfig = expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
@@ -600,14 +855,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		return nil, diags
 	}
 
+	applyDefaultTimeouts(provider, meta.DefaultTimeouts)
+
 	return meta, diags
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Ordered list of IAM Roles to assume prior to making API calls. Each role is assumed using the credentials obtained from the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -752,6 +1009,28 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+// expandAssumeRoles expands the ordered list of `assume_role` blocks.
+// Blocks without a `role_arn` are skipped.
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) []awsbase.AssumeRole {
+	var apiObjects []awsbase.AssumeRole
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAssumeRole(ctx, tfMap)
+		if apiObject == nil || apiObject.RoleARN == "" {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -852,9 +1131,9 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -867,7 +1146,138 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			pattern, err := tftags.NewPattern(v)
+			if err != nil {
+				return nil, err
+			}
+
+			ignoreConfig.KeyPatterns = append(ignoreConfig.KeyPatterns, pattern)
+		}
+	}
+
+	if v, ok := tfMap["tag_patterns"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var tagPattern tftags.TagPattern
+
+			if v, ok := tfMap["key"].(string); ok && v != "" {
+				pattern, err := tftags.NewPattern(v)
+				if err != nil {
+					return nil, err
+				}
+
+				tagPattern.Key = pattern
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				pattern, err := tftags.NewPattern(v)
+				if err != nil {
+					return nil, err
+				}
+
+				tagPattern.Value = pattern
+			}
+
+			// A pattern that would match every tag is ignored.
+			if tagPattern.Key == nil && tagPattern.Value == nil {
+				continue
+			}
+
+			ignoreConfig.TagPatterns = append(ignoreConfig.TagPatterns, tagPattern)
+		}
+	}
+
+	return ignoreConfig, nil
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) *tftags.PolicyConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{
+		Enforcement: tftags.PolicyEnforcementWarning,
+	}
+
+	if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.AllowedValues = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+			values := flex.ExpandStringValueSet(tfMap["values"].(*schema.Set))
+			policyConfig.AllowedValues[key] = append(policyConfig.AllowedValues[key], values...)
+		}
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		policyConfig.Enforcement = tftags.PolicyEnforcement(v)
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		policyConfig.KeyCase = tftags.KeyCase(v)
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(policyConfig.RequiredKeys)
+	}
+
+	return policyConfig
+}
+
+func expandConcurrencyLimits(_ context.Context, tfMap map[string]interface{}) (map[string]int, map[string]int, error) {
+	if tfMap == nil {
+		return nil, nil, nil
+	}
+
+	expandLimits := func(tfMap map[string]interface{}) (map[string]int, error) {
+		limits := make(map[string]int, len(tfMap))
+
+		for k, v := range tfMap {
+			v, ok := v.(int)
+			if !ok {
+				continue
+			}
+
+			if v < 1 {
+				return nil, fmt.Errorf("concurrency limit for %s must be at least 1, got: %d", k, v)
+			}
+
+			limits[k] = v
+		}
+
+		return limits, nil
+	}
+
+	var servicePackageLimits, resourceTypeLimits map[string]int
+	var err error
+
+	if v, ok := tfMap["service_packages"].(map[string]interface{}); ok && len(v) > 0 {
+		servicePackageLimits, err = expandLimits(v)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if v, ok := tfMap["resource_types"].(map[string]interface{}); ok && len(v) > 0 {
+		resourceTypeLimits, err = expandLimits(v)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return servicePackageLimits, resourceTypeLimits, nil
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether a resource or data source has the per-resource `region` argument.
+// All resources and data sources in regional services have the argument unless they opt out using `@Region(overrideEnabled=false)`.
+// CRUD handlers obtain the AWS Region (e.g. for building ARNs) from the Context, not from the provider's configured Region.
+func isRegionOverrideEnabled(servicePackageName string, region *types.ServicePackageResourceRegion) bool {
+	return !names.IsGlobalService(servicePackageName) && (region == nil || region.IsOverrideEnabled)
+}
+
+// regionDataSourceSchema returns the schema for a regional data source's `region` argument.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to read the data source from. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema for a regional resource's `region` argument.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to manage the resource in. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds a `region` argument to the specified resource or data source's schema.
+// Returns false if the schema already has a top-level `region` attribute, which takes precedence.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = s
+	}
+
+	return true
+}
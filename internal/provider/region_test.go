@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIsRegionOverrideEnabled(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		region             *types.ServicePackageResourceRegion
+		expected           bool
+	}{
+		{
+			name:               "no annotation",
+			servicePackageName: names.SQS,
+			expected:           true,
+		},
+		{
+			name:               "override disabled",
+			servicePackageName: names.SQS,
+			region:             &types.ServicePackageResourceRegion{},
+		},
+		{
+			name:               "override enabled",
+			servicePackageName: names.SQS,
+			region:             &types.ServicePackageResourceRegion{IsOverrideEnabled: true},
+			expected:           true,
+		},
+		{
+			name:               "global service",
+			servicePackageName: names.IAM,
+		},
+		{
+			name:               "global service override enabled",
+			servicePackageName: names.IAM,
+			region:             &types.ServicePackageResourceRegion{IsOverrideEnabled: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isRegionOverrideEnabled(testCase.servicePackageName, testCase.region), testCase.expected; got != want {
+				t.Errorf("isRegionOverrideEnabled = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		expected bool
+	}{
+		{
+			name: "Schema",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "SchemaFunc",
+			resource: &schema.Resource{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					}
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "existing region attribute",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrRegion: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := injectRegionAttribute(testCase.resource, regionResourceSchema()), testCase.expected; got != want {
+				t.Errorf("injected = %t, want %t", got, want)
+			}
+
+			v, ok := testCase.resource.SchemaMap()[names.AttrRegion]
+			if !ok {
+				t.Fatalf("no %s attribute", names.AttrRegion)
+			}
+			if got, want := v.Optional, testCase.expected; got != want {
+				t.Errorf("Optional = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	injectRegionAttribute(r, regionResourceSchema())
+
+	interceptors := interceptorItems{
+		{
+			when:        Before | After,
+			why:         AllOps,
+			interceptor: regionInterceptor{},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, names.EC2, "VPC")
+	}
+	meta := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		name     string
+		config   map[string]any
+		expected string
+	}{
+		{
+			name:     "default",
+			config:   map[string]any{},
+			expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name: "override",
+			config: map[string]any{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			expected: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			var read schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				got = meta.(*conns.AWSClient).ResourceRegion(ctx)
+				return nil
+			}
+
+			d := schema.TestResourceDataRaw(t, r.SchemaMap(), testCase.config)
+			d.SetId("vpc-12345678")
+
+			diags := interceptedHandler(bootstrapContext, interceptors, read, Read)(context.Background(), d, meta)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("CRUD handler region = %s, want %s", got, testCase.expected)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expected; got != want {
+				t.Errorf("%s = %s, want %s", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
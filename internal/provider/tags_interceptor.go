@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -172,3 +173,25 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagPolicyDiags returns diagnostics for any violations of the provider-level tag policy by the specified tags.
+// Violations are errors if the policy is enforced, otherwise warnings.
+func tagPolicyDiags(policyConfig *tftags.PolicyConfig, tags tftags.KeyValueTags) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	severity := diag.Warning
+	if policyConfig.IsError() {
+		severity = diag.Error
+	}
+
+	for _, err := range policyConfig.Violations(tags) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       "Tag Policy Violation",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath(names.AttrTags),
+		})
+	}
+
+	return diags
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
@@ -81,9 +82,9 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
 			"tag": "",
 		}),
-		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
+		IgnoreTagsConfig: errs.Must(expandIgnoreTags(context.Background(), map[string]interface{}{
 			"tag2": "tag",
-		}),
+		})),
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
@@ -148,3 +149,70 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func TestTagPolicyDiags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := tftags.New(ctx, map[string]string{
+		"cost-center": "x",
+	})
+
+	testCases := []struct {
+		name             string
+		policyConfig     *tftags.PolicyConfig
+		expectedSeverity diag.Severity
+		expectedDiags    int
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "compliant",
+			policyConfig: &tftags.PolicyConfig{
+				Enforcement: tftags.PolicyEnforcementError,
+				KeyCase:     tftags.KeyCaseKebab,
+			},
+		},
+		{
+			name: "violation warning",
+			policyConfig: &tftags.PolicyConfig{
+				KeyCase:      tftags.KeyCasePascal,
+				RequiredKeys: []string{"Owner"},
+			},
+			expectedSeverity: diag.Warning,
+			expectedDiags:    2,
+		},
+		{
+			name: "violation error",
+			policyConfig: &tftags.PolicyConfig{
+				Enforcement:  tftags.PolicyEnforcementError,
+				KeyCase:      tftags.KeyCasePascal,
+				RequiredKeys: []string{"Owner"},
+			},
+			expectedSeverity: diag.Error,
+			expectedDiags:    2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tagPolicyDiags(testCase.policyConfig, tags)
+
+			if got, want := len(diags), testCase.expectedDiags; got != want {
+				t.Fatalf("length of diags = %d, want %d", got, want)
+			}
+			for _, d := range diags {
+				if got, want := d.Severity, testCase.expectedSeverity; got != want {
+					t.Errorf("severity = %v, want %v", got, want)
+				}
+				if !d.AttributePath.Equals(cty.GetAttrPath("tags")) {
+					t.Errorf("attribute path = %#v, want tags", d.AttributePath)
+				}
+			}
+		})
+	}
+}
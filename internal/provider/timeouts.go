@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// expandDefaultTimeouts returns the provider's default timeouts, keyed by resource type or glob pattern.
+func expandDefaultTimeouts(_ context.Context, tfList []interface{}) (map[string]conns.Timeouts, error) {
+	defaultTimeouts := make(map[string]conns.Timeouts, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourceType := tfMap["resource_type"].(string)
+
+		if _, err := path.Match(resourceType, ""); err != nil {
+			return nil, fmt.Errorf("default_timeouts resource_type (%s): %w", resourceType, err)
+		}
+
+		if _, ok := defaultTimeouts[resourceType]; ok {
+			return nil, fmt.Errorf("duplicate default_timeouts resource_type: %s", resourceType)
+		}
+
+		var timeouts conns.Timeouts
+
+		for k, v := range map[string]*time.Duration{
+			"create": &timeouts.Create,
+			"read":   &timeouts.Read,
+			"update": &timeouts.Update,
+			"delete": &timeouts.Delete,
+		} {
+			if s, ok := tfMap[k].(string); ok && s != "" {
+				duration, err := time.ParseDuration(s)
+				if err != nil {
+					return nil, fmt.Errorf("default_timeouts (%s) %s: %w", resourceType, k, err)
+				}
+
+				*v = duration
+			}
+		}
+
+		defaultTimeouts[resourceType] = timeouts
+	}
+
+	return defaultTimeouts, nil
+}
+
+// applyDefaultTimeouts overrides the default timeouts of the provider's Plugin SDK resources
+// with any provider configured default timeouts.
+// Plugin SDK resources record their timeouts at plan time so, unlike Terraform Plugin Framework resources,
+// defaults cannot be applied by interceptors and must be in place once the provider is configured.
+// Only operations that a resource already supports timeouts for are affected.
+func applyDefaultTimeouts(provider *schema.Provider, defaultTimeouts func(string) (conns.Timeouts, bool)) {
+	for typeName, r := range provider.ResourcesMap {
+		if r.Timeouts == nil {
+			continue
+		}
+
+		v, ok := defaultTimeouts(typeName)
+		if !ok {
+			continue
+		}
+
+		override := func(timeout **time.Duration, duration time.Duration) {
+			if *timeout != nil && duration > 0 {
+				*timeout = &duration
+			}
+		}
+
+		// Copy as the resource's timeouts may be shared.
+		timeouts := *r.Timeouts
+		override(&timeouts.Create, v.Create)
+		override(&timeouts.Read, v.Read)
+		override(&timeouts.Update, v.Update)
+		override(&timeouts.Delete, v.Delete)
+		r.Timeouts = &timeouts
+	}
+}
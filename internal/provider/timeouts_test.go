@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		tfList      []interface{}
+		expected    map[string]conns.Timeouts
+		expectError bool
+	}{
+		{
+			name: "valid",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_db_instance",
+					"create":        "90m",
+					"read":          "",
+					"update":        "1h",
+					"delete":        "",
+				},
+				map[string]interface{}{
+					"resource_type": "aws_rds_*",
+					"create":        "",
+					"read":          "",
+					"update":        "",
+					"delete":        "30m",
+				},
+			},
+			expected: map[string]conns.Timeouts{
+				"aws_db_instance": {
+					Create: 90 * time.Minute,
+					Update: time.Hour,
+				},
+				"aws_rds_*": {
+					Delete: 30 * time.Minute,
+				},
+			},
+		},
+		{
+			name: "duplicate resource type",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_db_instance",
+					"create":        "90m",
+				},
+				map[string]interface{}{
+					"resource_type": "aws_db_instance",
+					"delete":        "90m",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid pattern",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_[",
+					"create":        "90m",
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandDefaultTimeouts(context.Background(), testCase.tfList)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	resourceTimeouts := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(10 * time.Minute),
+		Delete: schema.DefaultTimeout(10 * time.Minute),
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_db_instance": {
+				Timeouts: resourceTimeouts,
+			},
+			"aws_db_subnet_group": {},
+			"aws_vpc": {
+				Timeouts: resourceTimeouts,
+			},
+		},
+	}
+	defaultTimeouts := func(typeName string) (conns.Timeouts, bool) {
+		if typeName == "aws_vpc" {
+			return conns.Timeouts{}, false
+		}
+
+		return conns.Timeouts{
+			Create: 90 * time.Minute,
+			Update: 90 * time.Minute,
+		}, true
+	}
+
+	applyDefaultTimeouts(provider, defaultTimeouts)
+
+	r := provider.ResourcesMap["aws_db_instance"]
+	if got, want := *r.Timeouts.Create, 90*time.Minute; got != want {
+		t.Errorf("aws_db_instance Create timeout = %s, want %s", got, want)
+	}
+	if got, want := *r.Timeouts.Delete, 10*time.Minute; got != want {
+		t.Errorf("aws_db_instance Delete timeout = %s, want %s", got, want)
+	}
+	// Operations without resource timeouts are not affected.
+	if r.Timeouts.Update != nil {
+		t.Errorf("aws_db_instance Update timeout = %s, want nil", *r.Timeouts.Update)
+	}
+
+	if r := provider.ResourcesMap["aws_db_subnet_group"]; r.Timeouts != nil {
+		t.Error("aws_db_subnet_group has timeouts")
+	}
+
+	// Shared resource timeouts are not modified.
+	if got, want := *provider.ResourcesMap["aws_vpc"].Timeouts.Create, 10*time.Minute; got != want {
+		t.Errorf("aws_vpc Create timeout = %s, want %s", got, want)
+	}
+	if got, want := *resourceTimeouts.Create, 10*time.Minute; got != want {
+		t.Errorf("shared Create timeout = %s, want %s", got, want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -37,3 +38,28 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validIgnoreTagsPattern validates a string is a valid `ignore_tags` glob or regular expression pattern.
+func validIgnoreTagsPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := tftags.NewPattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// validDefaultTimeout validates a string is a positive `default_timeouts` duration.
+func validDefaultTimeout(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration", k))
+	}
+
+	return
+}
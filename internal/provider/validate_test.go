@@ -73,3 +73,86 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidIgnoreTagsPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "key1",
+		},
+		{
+			val: "team/*/owner",
+		},
+		{
+			val: `/^team\/[^\/]+\/owner$/`,
+		},
+		{
+			val:         `/[/`,
+			expectedErr: regexache.MustCompile(`invalid tag pattern`),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validIgnoreTagsPattern(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
+func TestValidDefaultTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "90m",
+		},
+		{
+			val: "1h30m",
+		},
+		{
+			val:         "90",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "0s",
+			expectedErr: regexache.MustCompile(`must be a positive duration`),
+		},
+		{
+			val:         "-5m",
+			expectedErr: regexache.MustCompile(`must be a positive duration`),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validDefaultTimeout(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// When retries the function `f` when the error it returns satisfies `retryable`, using the specified retry engine.
+// `f` is retried until `timeout` expires, after which `f` is called one final time if no attempt returned an error.
+// It has the semantics, including the shape of returned errors, of tfresource.RetryGWhen,
+// which delegates to it when the Context carries a retry engine.
+func When[T any](ctx context.Context, engine *Engine, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
+	var output T
+
+	err := engine.Retry(ctx, timeout, func(context.Context) error {
+		var err error
+
+		output, err = f()
+
+		return err
+	}, retryable)
+
+	if timedOut(err) {
+		output, err = f()
+	}
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return output, nil
+}
+
+// timedOut returns true if the error represents a timeout with no last error.
+// It's equivalent to tfresource.TimedOut, which can't be used here as tfresource delegates to this package.
+func timedOut(err error) bool {
+	timeoutErr, ok := err.(*sdkretry.TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+	return ok && timeoutErr.LastError == nil
+}
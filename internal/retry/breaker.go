@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned when an operation is not attempted because its circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open after sustained throttling")
+
+// CircuitBreakerState is the state of a circuit breaker.
+type CircuitBreakerState string
+
+const (
+	CircuitBreakerStateClosed   CircuitBreakerState = "closed"
+	CircuitBreakerStateHalfOpen CircuitBreakerState = "half-open"
+	CircuitBreakerStateOpen     CircuitBreakerState = "open"
+)
+
+// CircuitBreaker fails operations fast after sustained throttling.
+// The breaker opens once `threshold` consecutive operations have been throttled and stays open for `cooldown`.
+// After the cooldown the breaker is half-open: operations are attempted again and a single further throttled
+// operation re-opens the breaker, while any other outcome closes it.
+type CircuitBreaker struct {
+	cooldown  time.Duration
+	failures  int
+	lock      sync.Mutex
+	now       func() time.Time
+	openUntil time.Time
+	threshold int
+}
+
+// NewCircuitBreaker returns a new, closed circuit breaker.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		cooldown:  cooldown,
+		now:       time.Now,
+		threshold: max(threshold, 1),
+	}
+}
+
+// State returns the circuit breaker's current state.
+func (cb *CircuitBreaker) State() CircuitBreakerState {
+	if cb == nil {
+		return CircuitBreakerStateClosed
+	}
+
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	return cb.state()
+}
+
+func (cb *CircuitBreaker) state() CircuitBreakerState {
+	switch {
+	case cb.openUntil.IsZero():
+		return CircuitBreakerStateClosed
+	case cb.now().Before(cb.openUntil):
+		return CircuitBreakerStateOpen
+	default:
+		return CircuitBreakerStateHalfOpen
+	}
+}
+
+// allow returns ErrCircuitOpen if operations must not be attempted.
+// A nil CircuitBreaker never opens.
+func (cb *CircuitBreaker) allow() error {
+	if cb == nil {
+		return nil
+	}
+
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	if cb.state() == CircuitBreakerStateOpen {
+		return ErrCircuitOpen
+	}
+
+	return nil
+}
+
+// record records the outcome of an operation.
+func (cb *CircuitBreaker) record(throttled bool) {
+	if cb == nil {
+		return
+	}
+
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	if !throttled {
+		cb.failures = 0
+		cb.openUntil = time.Time{}
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= cb.threshold || cb.state() == CircuitBreakerStateHalfOpen {
+		cb.failures = 0
+		cb.openUntil = cb.now().Add(cb.cooldown)
+	}
+}
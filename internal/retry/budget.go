@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrBudgetExhausted is returned when a retry loop stops because the shared retry budget has been used up.
+var ErrBudgetExhausted = errors.New("retry budget exhausted")
+
+const (
+	defaultBudgetCapacity = 500
+	defaultBudgetCost     = 5
+	defaultBudgetRefund   = 1
+)
+
+// Budget is a token bucket shared by concurrent retry loops, for example all the resources managed by one provider instance.
+// Each retry of a throttled operation costs tokens and each successful operation refunds tokens.
+// Once the budget is exhausted throttled operations are no longer retried, so that one throttled API can't starve
+// every other operation of its share of the account's request rate.
+// The model follows the AWS SDKs' client-side retry quota.
+type Budget struct {
+	capacity int
+	cost     int
+	lock     sync.Mutex
+	refund   int
+	tokens   int
+}
+
+// NewBudget returns a new full retry budget of the specified capacity.
+// Each throttled retry costs 5 tokens and each success refunds 1 token.
+func NewBudget(capacity int) *Budget {
+	return &Budget{
+		capacity: capacity,
+		cost:     defaultBudgetCost,
+		refund:   defaultBudgetRefund,
+		tokens:   capacity,
+	}
+}
+
+// Remaining returns the number of tokens remaining in the budget.
+func (b *Budget) Remaining() int {
+	if b == nil {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.tokens
+}
+
+// acquire takes the cost of a retry from the budget, returning false if the budget is exhausted.
+// A nil Budget is unlimited.
+func (b *Budget) acquire() bool {
+	if b == nil {
+		return true
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.tokens < b.cost {
+		return false
+	}
+
+	b.tokens -= b.cost
+
+	return true
+}
+
+// release refunds tokens to the budget after a successful operation.
+func (b *Budget) release() {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = min(b.tokens+b.refund, b.capacity)
+}
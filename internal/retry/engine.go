@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Retryable is a function that is used to decide if a function's error is retryable or not.
+// The error argument can be `nil`.
+// If the error is retryable, returns a bool value of `true` and an error (not necessarily the error passed as the argument).
+// If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
+type Retryable func(error) (bool, error)
+
+const (
+	defaultCircuitBreakerThreshold = 10
+	defaultCircuitBreakerCooldown  = 1 * time.Minute
+)
+
+// Engine runs retry loops with jittered exponential backoff.
+// All retry loops run by an Engine draw on a single retry budget.
+// Each scope, typically an AWS service package, has its own circuit breaker so that sustained throttling of one
+// service's APIs doesn't fail operations on another's.
+type Engine struct {
+	breakerCooldown  time.Duration
+	breakerThreshold int
+	breakers         map[string]*CircuitBreaker
+	budget           *Budget
+	lock             sync.Mutex
+	options          Options
+}
+
+// EngineOptionsFunc is a type alias for an Engine functional option.
+type EngineOptionsFunc func(*Engine)
+
+// WithBackoff sets the backoff policy used by an Engine's retry loops.
+func WithBackoff(options Options) EngineOptionsFunc {
+	return func(e *Engine) {
+		e.options = options
+	}
+}
+
+// WithBudget sets the retry budget shared by an Engine's retry loops.
+// A nil Budget is unlimited.
+func WithBudget(budget *Budget) EngineOptionsFunc {
+	return func(e *Engine) {
+		e.budget = budget
+	}
+}
+
+// WithCircuitBreaker configures the circuit breaker created for each of an Engine's scopes.
+// A threshold of zero disables the circuit breakers.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) EngineOptionsFunc {
+	return func(e *Engine) {
+		e.breakerThreshold = threshold
+		e.breakerCooldown = cooldown
+	}
+}
+
+// NewEngine returns a new retry engine.
+// By default the backoff starts at 500ms, doubles on each attempt up to 10s and has equal jitter,
+// the retry budget has a capacity of 500 tokens and a scope's circuit breaker opens after 10 consecutive throttled
+// operations for 1 minute.
+func NewEngine(optFns ...EngineOptionsFunc) *Engine {
+	e := &Engine{
+		breakerCooldown:  defaultCircuitBreakerCooldown,
+		breakerThreshold: defaultCircuitBreakerThreshold,
+		breakers:         make(map[string]*CircuitBreaker),
+		budget:           NewBudget(defaultBudgetCapacity),
+		options: Options{
+			BackoffMinDuration: 500 * time.Millisecond,
+			BackoffMaxDuration: 10 * time.Second,
+			BackoffMultiplier:  2,
+			Jitter:             EqualJitter,
+		},
+	}
+
+	for _, optFn := range optFns {
+		optFn(e)
+	}
+
+	return e
+}
+
+// Budget returns the Engine's retry budget.
+func (e *Engine) Budget() *Budget {
+	return e.budget
+}
+
+// CircuitBreaker returns the circuit breaker for the specified scope, creating it if necessary.
+// Returns nil if circuit breakers are disabled.
+func (e *Engine) CircuitBreaker(scope string) *CircuitBreaker {
+	if e.breakerThreshold <= 0 {
+		return nil
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	cb, ok := e.breakers[scope]
+	if !ok {
+		cb = NewCircuitBreaker(e.breakerThreshold, e.breakerCooldown)
+		e.breakers[scope] = cb
+	}
+
+	return cb
+}
+
+// Retry calls `f` until `retryable` returns false, `timeout` elapses, the retry budget is exhausted or the scope's
+// circuit breaker opens.
+// If `timeout` elapses the error from the last attempt is returned, or a *retry.TimeoutError from the Terraform Plugin SDK
+// if there was none, as tfresource.Retry does.
+func (e *Engine) Retry(ctx context.Context, timeout time.Duration, f func(context.Context) error, retryable Retryable) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	scope := scopeFromContext(ctx)
+	cb := e.CircuitBreaker(scope)
+	start := time.Now()
+	var lastErr error
+
+	r := BeginWithOptions(e.options)
+	for r.Continue(ctx) {
+		attempt := r.Attempt()
+
+		if err := cb.allow(); err != nil {
+			if lastErr != nil {
+				err = fmt.Errorf("%w: %w", err, lastErr)
+			}
+			logRetryOutcome(ctx, scope, attempt, time.Since(start), err)
+			return err
+		}
+
+		err := f(ctx)
+		throttled := isThrottlingError(err)
+		cb.record(throttled)
+
+		again, err := retryable(err)
+
+		if !again {
+			if err == nil {
+				e.budget.release()
+			}
+			logRetryOutcome(ctx, scope, attempt, time.Since(start), err)
+			return err
+		}
+
+		lastErr = err
+
+		if throttled && !e.budget.acquire() {
+			err = fmt.Errorf("%w: %w", ErrBudgetExhausted, err)
+			logRetryOutcome(ctx, scope, attempt, time.Since(start), err)
+			return err
+		}
+
+		logRetryAttempt(ctx, scope, attempt, throttled, err, e.budget.Remaining(), cb.State())
+	}
+
+	err := lastErr
+	if err == nil {
+		err = &sdkretry.TimeoutError{
+			Timeout: timeout,
+		}
+	}
+	logRetryOutcome(ctx, scope, r.Attempt()-1, time.Since(start), err) // The final call to Continue didn't start an attempt.
+
+	return err
+}
+
+// throttleErrorCodes are the AWS API error codes that indicate throttling.
+var throttleErrorCodes = tfmaps.Keys(awsretry.DefaultThrottleErrorCodes)
+
+// isThrottlingError returns whether the error is an AWS API throttling error.
+func isThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return tfawserr.ErrCodeEquals(err, throttleErrorCodes...) || tfawserr_sdkv2.ErrCodeEquals(err, throttleErrorCodes...)
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+type inContext struct {
+	engine *Engine
+	scope  string
+}
+
+// NewContext returns a Context carrying the specified retry engine and circuit breaker scope.
+func NewContext(ctx context.Context, engine *Engine, scope string) context.Context {
+	return context.WithValue(ctx, contextKey, &inContext{
+		engine: engine,
+		scope:  scope,
+	})
+}
+
+// FromContext returns the retry engine carried by the Context, if any.
+func FromContext(ctx context.Context) (*Engine, bool) {
+	v, ok := ctx.Value(contextKey).(*inContext)
+	if !ok || v.engine == nil {
+		return nil, false
+	}
+
+	return v.engine, true
+}
+
+func scopeFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(contextKey).(*inContext); ok {
+		return v.scope
+	}
+
+	return ""
+}
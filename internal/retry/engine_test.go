@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+var (
+	errTest       = errors.New("test")
+	errThrottling = &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+)
+
+func testEngine(optFns ...EngineOptionsFunc) *Engine {
+	return NewEngine(append([]EngineOptionsFunc{WithBackoff(Options{
+		BackoffMinDuration: time.Millisecond,
+		BackoffMultiplier:  1,
+		Jitter:             NoJitter,
+	})}, optFns...)...)
+}
+
+func retryAlways(err error) (bool, error) {
+	return err != nil, err
+}
+
+func TestBackoffDelay(t *testing.T) {
+	t.Parallel()
+
+	r := BeginWithOptions(Options{
+		BackoffMinDuration: time.Second,
+		BackoffMaxDuration: 5 * time.Second,
+		BackoffMultiplier:  2,
+	})
+
+	var got []time.Duration
+	for r.attempt = 0; r.attempt < 5; r.attempt++ {
+		got = append(got, r.backoffDelay())
+	}
+
+	want := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("attempt %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+
+	const delay = time.Second
+
+	testCases := map[string]struct {
+		jitter   Jitter
+		min, max time.Duration
+	}{
+		"full":         {jitter: FullJitter, min: 0, max: delay},
+		"equal":        {jitter: EqualJitter, min: delay / 2, max: delay},
+		"none":         {jitter: NoJitter, min: delay, max: delay},
+		"proportional": {jitter: ProportionalJitter(0.25), min: delay * 3 / 4, max: delay},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for i := 0; i < 100; i++ {
+				if got := testCase.jitter(delay); got < testCase.min || got > testCase.max {
+					t.Fatalf("got %v, want between %v and %v", got, testCase.min, testCase.max)
+				}
+			}
+		})
+	}
+}
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	b := NewBudget(12)
+
+	if !b.acquire() || !b.acquire() {
+		t.Fatal("expected budget to be available")
+	}
+	if b.acquire() {
+		t.Fatal("expected budget to be exhausted")
+	}
+	if got, want := b.Remaining(), 2; got != want {
+		t.Errorf("remaining: got %d, want %d", got, want)
+	}
+
+	for i := 0; i < 20; i++ {
+		b.release()
+	}
+	if got, want := b.Remaining(), 12; got != want {
+		t.Errorf("remaining after refunds: got %d, want %d", got, want)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cb := NewCircuitBreaker(3, time.Minute)
+	cb.now = func() time.Time { return now }
+
+	cb.record(true)
+	cb.record(true)
+	cb.record(false)
+	cb.record(true)
+	cb.record(true)
+	if got, want := cb.State(), CircuitBreakerStateClosed; got != want {
+		t.Fatalf("state after intermittent throttling: got %s, want %s", got, want)
+	}
+
+	cb.record(true)
+	if got, want := cb.State(), CircuitBreakerStateOpen; got != want {
+		t.Fatalf("state after sustained throttling: got %s, want %s", got, want)
+	}
+	if err := cb.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow: got %v, want %v", err, ErrCircuitOpen)
+	}
+
+	now = now.Add(time.Minute)
+	if got, want := cb.State(), CircuitBreakerStateHalfOpen; got != want {
+		t.Fatalf("state after cooldown: got %s, want %s", got, want)
+	}
+	if err := cb.allow(); err != nil {
+		t.Fatalf("allow: unexpected error: %s", err)
+	}
+
+	cb.record(true)
+	if got, want := cb.State(), CircuitBreakerStateOpen; got != want {
+		t.Fatalf("state after half-open throttling: got %s, want %s", got, want)
+	}
+
+	now = now.Add(time.Minute)
+	cb.record(false)
+	if got, want := cb.State(), CircuitBreakerStateClosed; got != want {
+		t.Fatalf("state after half-open success: got %s, want %s", got, want)
+	}
+}
+
+func TestEngineRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("success after retries", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		err := testEngine().Retry(ctx, time.Second, func(context.Context) error {
+			if calls++; calls < 3 {
+				return errTest
+			}
+			return nil
+		}, retryAlways)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := calls, 3; got != want {
+			t.Errorf("calls: got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		err := testEngine().Retry(ctx, time.Second, func(context.Context) error {
+			calls++
+			return errTest
+		}, func(err error) (bool, error) {
+			return false, err
+		})
+
+		if !errors.Is(err, errTest) {
+			t.Fatalf("got %v, want %v", err, errTest)
+		}
+		if got, want := calls, 1; got != want {
+			t.Errorf("calls: got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		err := testEngine().Retry(ctx, 50*time.Millisecond, func(context.Context) error {
+			return errTest
+		}, retryAlways)
+
+		if !errors.Is(err, errTest) {
+			t.Fatalf("got %v, want %v", err, errTest)
+		}
+	})
+
+	t.Run("budget exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		err := testEngine(WithBudget(NewBudget(10)), WithCircuitBreaker(0, 0)).Retry(ctx, time.Second, func(context.Context) error {
+			calls++
+			return errThrottling
+		}, retryAlways)
+
+		if !errors.Is(err, ErrBudgetExhausted) {
+			t.Fatalf("got %v, want %v", err, ErrBudgetExhausted)
+		}
+		if got, want := calls, 3; got != want {
+			t.Errorf("calls: got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("budget not used for other errors", func(t *testing.T) {
+		t.Parallel()
+
+		budget := NewBudget(10)
+		var calls int
+		err := testEngine(WithBudget(budget)).Retry(ctx, time.Second, func(context.Context) error {
+			if calls++; calls < 5 {
+				return errTest
+			}
+			return nil
+		}, retryAlways)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := budget.Remaining(), 10; got != want {
+			t.Errorf("remaining: got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("circuit breaker", func(t *testing.T) {
+		t.Parallel()
+
+		engine := testEngine(WithCircuitBreaker(3, time.Minute))
+		ctx := NewContext(ctx, engine, "ec2")
+
+		var calls int
+		err := engine.Retry(ctx, time.Second, func(context.Context) error {
+			calls++
+			return errThrottling
+		}, retryAlways)
+
+		if !errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("got %v, want %v", err, ErrCircuitOpen)
+		}
+		if !errors.Is(err, errThrottling) {
+			t.Errorf("got %v, want wrapped %v", err, errThrottling)
+		}
+		if got, want := calls, 3; got != want {
+			t.Errorf("calls: got %d, want %d", got, want)
+		}
+
+		// Fail fast while the breaker is open.
+		calls = 0
+		err = engine.Retry(ctx, time.Second, func(context.Context) error {
+			calls++
+			return nil
+		}, retryAlways)
+
+		if !errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("got %v, want %v", err, ErrCircuitOpen)
+		}
+		if got, want := calls, 0; got != want {
+			t.Errorf("calls: got %d, want %d", got, want)
+		}
+
+		// Other scopes are unaffected.
+		err = engine.Retry(NewContext(ctx, engine, "s3"), time.Second, func(context.Context) error {
+			return nil
+		}, retryAlways)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+}
+
+func TestWhen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var calls int
+	got, err := When(ctx, testEngine(), time.Second, func() (int, error) {
+		if calls++; calls < 3 {
+			return 0, errTest
+		}
+		return 42, nil
+	}, retryAlways)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := 42; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if _, ok := FromContext(ctx); ok {
+		t.Fatal("expected no engine")
+	}
+	if _, ok := FromContext(NewContext(ctx, nil, "ec2")); ok {
+		t.Fatal("expected no engine")
+	}
+
+	engine := NewEngine()
+	if got, ok := FromContext(NewContext(ctx, engine, "ec2")); !ok || got != engine {
+		t.Fatalf("got %v, want %v", got, engine)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Jitter returns a randomized duration based on the specified backoff delay.
+// Randomizing delays spreads out retries from concurrent operations that failed at the same time.
+type Jitter func(time.Duration) time.Duration
+
+var defaultJitter = ProportionalJitter(0.4)
+
+// ProportionalJitter returns a Jitter that subtracts up to the specified fraction of the delay.
+func ProportionalJitter(fraction float64) Jitter {
+	return func(d time.Duration) time.Duration {
+		return time.Duration(float64(d) * (1 - fraction*randFloat64()))
+	}
+}
+
+// FullJitter returns a random duration between zero and the delay.
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/.
+func FullJitter(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * randFloat64())
+}
+
+// EqualJitter returns a random duration between half the delay and the delay.
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/.
+func EqualJitter(d time.Duration) time.Duration {
+	return d/2 + time.Duration(float64(d/2)*randFloat64())
+}
+
+// NoJitter returns the delay unchanged.
+func NoJitter(d time.Duration) time.Duration {
+	return d
+}
+
+var (
+	// Do not use the default RNG since we do not want different provider instances
+	// to pick the same deterministic random sequence.
+	rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	// A rand.Rand is not safe for concurrent use.
+	rngLock sync.Mutex
+)
+
+func randFloat64() float64 {
+	rngLock.Lock()
+	defer rngLock.Unlock()
+
+	return rng.Float64()
+}
@@ -6,7 +6,6 @@ package retry
 import (
 	"context"
 	"math"
-	"math/rand"
 	"time"
 )
 
@@ -16,7 +15,9 @@ import (
 // Before the ith iteration of the loop, retry.Continue() sleeps for a duraion of BackoffMinDuration * BackoffMultiplier**i, with added jitter.
 type Options struct {
 	BackoffMinDuration time.Duration
-	BackoffMultiplier  float64 // If specified, must be at least 1.
+	BackoffMaxDuration time.Duration // If specified, caps the delay before jitter is applied.
+	BackoffMultiplier  float64       // If specified, must be at least 1.
+	Jitter             Jitter        // If not specified, up to 40% of the delay is subtracted.
 }
 
 var defaultOptions = Options{
@@ -46,7 +47,7 @@ func Begin() *Retry {
 // The first call does not sleep.
 func (r *Retry) Continue(ctx context.Context) bool {
 	if r.attempt != 0 {
-		randomizedSleep(ctx, r.backoffDelay())
+		sleep(ctx, r.jitter()(r.backoffDelay()))
 	}
 	r.attempt++
 	return ctx.Err() == nil
@@ -58,21 +59,31 @@ func (r *Retry) Reset() {
 	r.attempt = 0
 }
 
+// Attempt returns the number of calls to Continue, i.e. the current attempt number inside a retry loop.
+func (r *Retry) Attempt() int {
+	return r.attempt
+}
+
 func (r *Retry) backoffDelay() time.Duration {
 	mult := math.Pow(r.options.BackoffMultiplier, float64(r.attempt))
-	return time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	delay := time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	if maxDelay := r.options.BackoffMaxDuration; maxDelay > 0 && (delay > maxDelay || delay < 0) {
+		delay = maxDelay
+	}
+	return delay
 }
 
-// Do not use the default RNG since we do not want different provider instances
-// to pick the same deterministic random sequence.
-var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+func (r *Retry) jitter() Jitter {
+	if r.options.Jitter == nil {
+		return defaultJitter
+	}
+	return r.options.Jitter
+}
 
 // Sleeps for a random duration close to the specified value or until context is done,
 // whichever occurs first.
 func randomizedSleep(ctx context.Context, d time.Duration) {
-	const jitter = 0.4
-	mult := 1 - jitter*rng.Float64() // Subtract up to 40%.
-	sleep(ctx, time.Duration(float64(d)*mult))
+	sleep(ctx, defaultJitter(d))
 }
 
 // Sleeps for the specified duration or until context is done, whichever occurs first.
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftracing "github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// logRetryAttempt records a failed attempt that will be retried, both as a log entry and as an event on the current span.
+func logRetryAttempt(ctx context.Context, scope string, attempt int, throttled bool, err error, budgetRemaining int, breakerState CircuitBreakerState) {
+	tflog.Debug(ctx, "Retrying operation", map[string]any{
+		"tf_aws.retry.attempt":               attempt,
+		"tf_aws.retry.budget_remaining":      budgetRemaining,
+		"tf_aws.retry.circuit_breaker_state": string(breakerState),
+		"tf_aws.retry.error":                 errorString(err),
+		"tf_aws.retry.scope":                 scope,
+		"tf_aws.retry.throttled":             throttled,
+	})
+
+	trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+		tftracing.AttrKeyRetryCount.Int(attempt),
+		attribute.Bool("aws.throttled", throttled),
+		attribute.Int("tf_aws.retry.budget_remaining", budgetRemaining),
+		attribute.String("tf_aws.retry.circuit_breaker_state", string(breakerState)),
+	))
+}
+
+// logRetryOutcome records the end of a retry loop.
+func logRetryOutcome(ctx context.Context, scope string, attempts int, elapsed time.Duration, err error) {
+	fields := map[string]any{
+		"tf_aws.retry.attempts": attempts,
+		"tf_aws.retry.elapsed":  elapsed.String(),
+		"tf_aws.retry.scope":    scope,
+	}
+	if err != nil {
+		fields["tf_aws.retry.error"] = err.Error()
+	}
+
+	// Only loops that retried are interesting.
+	if attempts == 1 {
+		tflog.Trace(ctx, "Operation completed without retries", fields)
+		return
+	}
+
+	tflog.Debug(ctx, "Retry loop completed", fields)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
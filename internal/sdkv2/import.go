@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+)
+
+// ImportByCompositeID returns a resource importer which imports state via the specified composite identifier.
+// Each part of the identifier is imported into the top-level attribute with the part's name,
+// converting the value to a number or boolean if that is the attribute's type, and the resource's ID is set to the identifier.
+// Identifiers may also be a JSON object keyed by part name, for use in Terraform `import` blocks.
+func ImportByCompositeID(id compositeid.ID) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			values, err := id.Parse(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			for i, part := range id.Parts {
+				// Empty optional parts are left unset.
+				if values[i] == "" {
+					continue
+				}
+
+				v, err := importValue(d.Get(part.Name), values[i])
+				if err != nil {
+					return nil, fmt.Errorf("parsing resource ID, %s (%s): %w", part.Name, values[i], err)
+				}
+
+				if err := d.Set(part.Name, v); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", part.Name, err)
+				}
+			}
+
+			d.SetId(id.Format(values...))
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// importValue returns the value, of the same type as the attribute's zero value, for an identifier part's value.
+func importValue(zero any, s string) (any, error) {
+	switch zero.(type) {
+	case int:
+		return strconv.Atoi(s)
+	case float64:
+		return strconv.ParseFloat(s, 64)
+	case bool:
+		return strconv.ParseBool(s)
+	default:
+		return s, nil
+	}
+}
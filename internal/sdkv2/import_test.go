@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+)
+
+func TestImportByCompositeID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &schema.Resource{
+		Importer: ImportByCompositeID(compositeid.ID{
+			Parts: []compositeid.Part{
+				{Name: "cluster"},
+				{Name: "priority"},
+				{Name: "enabled", Optional: true},
+			},
+		}),
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		id              string
+		expectedID      string
+		expectedEnabled bool
+		expectError     bool
+	}{
+		{
+			name:            "separated",
+			id:              "example,10,true",
+			expectedID:      "example,10,true",
+			expectedEnabled: true,
+		},
+		{
+			name:       "JSON",
+			id:         `{"cluster":"example","priority":"10"}`,
+			expectedID: "example,10,",
+		},
+		{
+			name:        "wrong part count",
+			id:          "example,10",
+			expectError: true,
+		},
+		{
+			name:        "wrong type",
+			id:          "example,high,true",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := r.Data(nil)
+			d.SetId(testCase.id)
+
+			results, err := r.Importer.StateContext(ctx, d, nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := len(results), 1; got != want {
+				t.Fatalf("len(ResourceData) = %d, want %d", got, want)
+			}
+			if got, want := results[0].Id(), testCase.expectedID; got != want {
+				t.Errorf("Id() = %s, want %s", got, want)
+			}
+			if got, want := results[0].Get("cluster").(string), "example"; got != want {
+				t.Errorf("cluster = %s, want %s", got, want)
+			}
+			if got, want := results[0].Get("priority").(int), 10; got != want {
+				t.Errorf("priority = %d, want %d", got, want)
+			}
+			if got, want := results[0].Get("enabled").(bool), testCase.expectedEnabled; got != want {
+				t.Errorf("enabled = %t, want %t", got, want)
+			}
+		})
+	}
+}
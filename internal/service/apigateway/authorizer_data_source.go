@@ -80,7 +80,7 @@ func dataSourceAuthorizerRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(authorizerID)
-	d.Set("arn", authorizerARN(meta.(*conns.AWSClient), apiID, d.Id()))
+	d.Set("arn", authorizerARN(ctx, meta.(*conns.AWSClient), apiID, d.Id()))
 	d.Set("authorizer_credentials", authorizer.AuthorizerCredentials)
 	if authorizer.AuthorizerResultTtlInSeconds != nil { // nosemgrep:ci.helper-schema-ResourceData-Set-extraneous-nil-check
 		d.Set("authorizer_result_ttl_in_seconds", authorizer.AuthorizerResultTtlInSeconds)
@@ -108,7 +108,7 @@ func resourceClientCertificateRead(ctx context.Context, d *schema.ResourceData,
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "apigateway",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("/clientcertificates/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
@@ -250,7 +250,7 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Domain Name (%s): %s", d.Id(), err)
 	}
 
-	d.Set("arn", domainNameARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("arn", domainNameARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("certificate_arn", domainName.CertificateArn)
 	d.Set("certificate_name", domainName.CertificateName)
 	if domainName.CertificateUploadDate != nil {
@@ -516,11 +516,11 @@ func flattenMutualTLSAuthentication(apiObject *types.MutualTlsAuthentication) []
 	return []interface{}{tfMap}
 }
 
-func domainNameARN(c *conns.AWSClient, domainName string) string {
+func domainNameARN(ctx context.Context, c *conns.AWSClient, domainName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("/domainnames/%s", domainName),
 	}.String()
 }
@@ -101,7 +101,7 @@ func dataSourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(aws.ToString(output.DomainName))
-	d.Set("arn", domainNameARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("arn", domainNameARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("certificate_arn", output.CertificateArn)
 	d.Set("certificate_name", output.CertificateName)
 	if output.CertificateUploadDate != nil {
@@ -239,7 +239,7 @@ func resourceUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta int
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "apigateway",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("/usageplans/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
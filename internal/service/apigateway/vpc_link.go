@@ -115,7 +115,7 @@ func resourceVPCLinkRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway VPC Link (%s): %s", d.Id(), err)
 	}
 
-	d.Set("arn", vpcLinkARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("arn", vpcLinkARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("description", vpcLink.Description)
 	d.Set("name", vpcLink.Name)
 	d.Set("target_arns", vpcLink.TargetArns)
@@ -278,11 +278,11 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigateway.Client, id string)
 	return nil, err
 }
 
-func vpcLinkARN(c *conns.AWSClient, vpcLinkID string) string {
+func vpcLinkARN(ctx context.Context, c *conns.AWSClient, vpcLinkID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("/vpclinks/%s", vpcLinkID),
 	}.String()
 }
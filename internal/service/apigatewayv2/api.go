@@ -251,13 +251,13 @@ func resourceAPIRead(ctx context.Context, d *schema.ResourceData, meta interface
 
 	d.Set("api_endpoint", output.ApiEndpoint)
 	d.Set("api_key_selection_expression", output.ApiKeySelectionExpression)
-	d.Set("arn", apiARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("arn", apiARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	if err := d.Set("cors_configuration", flattenCORSConfiguration(output.CorsConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cors_configuration: %s", err)
 	}
 	d.Set("description", output.Description)
 	d.Set("disable_execute_api_endpoint", output.DisableExecuteApiEndpoint)
-	d.Set("execution_arn", apiInvokeARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("execution_arn", apiInvokeARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("name", output.Name)
 	d.Set("protocol_type", output.ProtocolType)
 	d.Set("route_selection_expression", output.RouteSelectionExpression)
@@ -493,20 +493,20 @@ func flattenCORSConfiguration(configuration *awstypes.Cors) []interface{} {
 	}}
 }
 
-func apiARN(c *conns.AWSClient, apiID string) string {
+func apiARN(ctx context.Context, c *conns.AWSClient, apiID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		Resource:  "/apis/" + apiID,
 	}.String()
 }
 
-func apiInvokeARN(c *conns.AWSClient, apiID string) string {
+func apiInvokeARN(ctx context.Context, c *conns.AWSClient, apiID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "execute-api",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		AccountID: c.AccountID,
 		Resource:  apiID,
 	}.String()
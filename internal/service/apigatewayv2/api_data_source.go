@@ -125,13 +125,13 @@ func dataSourceAPIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.SetId(apiID)
 	d.Set("api_endpoint", api.ApiEndpoint)
 	d.Set("api_key_selection_expression", api.ApiKeySelectionExpression)
-	d.Set("arn", apiARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("arn", apiARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	if err := d.Set("cors_configuration", flattenCORSConfiguration(api.CorsConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cors_configuration: %s", err)
 	}
 	d.Set("description", api.Description)
 	d.Set("disable_execute_api_endpoint", api.DisableExecuteApiEndpoint)
-	d.Set("execution_arn", apiInvokeARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("execution_arn", apiInvokeARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("name", api.Name)
 	d.Set("protocol_type", api.ProtocolType)
 	d.Set("route_selection_expression", api.RouteSelectionExpression)
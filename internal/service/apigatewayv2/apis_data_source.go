@@ -73,7 +73,7 @@ func dataSourceAPIsRead(ctx context.Context, d *schema.ResourceData, meta interf
 		ids = append(ids, api.ApiId)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 
 	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ids: %s", err)
@@ -268,7 +268,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("access_log_settings", flattenAccessLogSettings(outputGS.AccessLogSettings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting access_log_settings: %s", err)
 	}
-	d.Set("arn", stageARN(meta.(*conns.AWSClient), apiID, stageName))
+	d.Set("arn", stageARN(ctx, meta.(*conns.AWSClient), apiID, stageName))
 	d.Set("auto_deploy", outputGS.AutoDeploy)
 	d.Set("client_certificate_id", outputGS.ClientCertificateId)
 	if err := d.Set("default_route_settings", flattenDefaultRouteSettings(outputGS.DefaultRouteSettings)); err != nil {
@@ -276,7 +276,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.Set("deployment_id", outputGS.DeploymentId)
 	d.Set("description", outputGS.Description)
-	d.Set("execution_arn", stageInvokeARN(meta.(*conns.AWSClient), apiID, stageName))
+	d.Set("execution_arn", stageInvokeARN(ctx, meta.(*conns.AWSClient), apiID, stageName))
 	d.Set("name", stageName)
 	if err := d.Set("route_settings", flattenRouteSettings(outputGS.RouteSettings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting route_settings: %s", err)
@@ -586,20 +586,20 @@ func flattenRouteSettings(settings map[string]awstypes.RouteSettings) []interfac
 	return vSettings
 }
 
-func stageARN(c *conns.AWSClient, apiID, stageName string) string {
+func stageARN(ctx context.Context, c *conns.AWSClient, apiID, stageName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("/apis/%s/stages/%s", apiID, stageName),
 	}.String()
 }
 
-func stageInvokeARN(c *conns.AWSClient, apiID, stageName string) string {
+func stageInvokeARN(ctx context.Context, c *conns.AWSClient, apiID, stageName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "execute-api",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		AccountID: c.AccountID,
 		Resource:  fmt.Sprintf("%s/%s", apiID, stageName),
 	}.String()
@@ -114,7 +114,7 @@ func resourceVPCLinkRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 VPC Link (%s): %s", d.Id(), err)
 	}
 
-	d.Set("arn", vpcLinkARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("arn", vpcLinkARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("name", output.Name)
 	d.Set("security_group_ids", output.SecurityGroupIds)
 	d.Set("subnet_ids", output.SubnetIds)
@@ -257,11 +257,11 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigatewayv2.Client, vpcLinkI
 	return nil, err
 }
 
-func vpcLinkARN(c *conns.AWSClient, vpcLinkID string) string {
+func vpcLinkARN(ctx context.Context, c *conns.AWSClient, vpcLinkID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		Resource:  "/vpclinks/" + vpcLinkID,
 	}.String()
 }
@@ -113,7 +113,7 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("application/%s", aws.ToString(output.Id)),
 		Service:   "appconfig",
 	}.String()
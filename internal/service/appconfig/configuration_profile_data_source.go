@@ -109,7 +109,7 @@ func dataSourceConfigurationProfileRead(ctx context.Context, d *schema.ResourceD
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s", appId, profileId),
 		Service:   "appconfig",
 	}.String()
@@ -132,7 +132,7 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  "application/resource-group/" + aws.StringValue(application.ResourceGroupName),
 		Service:   "applicationinsights",
 	}.String()
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
 		Name: "aws_applicationinsights_application",
 		F:    sweepApplications,
 	})
@@ -65,7 +65,7 @@ func (d *hostedZoneIDDataSource) Read(ctx context.Context, request datasource.Re
 
 	var region string
 	if data.Region.IsNull() {
-		region = d.Meta().Region
+		region = d.Meta().ResourceRegion(ctx)
 	} else {
 		region = data.Region.ValueString()
 	}
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
 		Name: "aws_apprunner_auto_scaling_configuration_version",
 		F:    sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
 		Name: "aws_apprunner_connection",
 		F:    sweepConnections,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
@@ -304,7 +304,7 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("additional_authentication_provider"); ok {
-		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
+		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(ctx))
 	}
 
 	if v, ok := d.GetOk("lambda_authorizer_config"); ok {
@@ -320,7 +320,7 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("user_pool_config"); ok {
-		input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region)
+		input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(ctx))
 	}
 
 	if v, ok := d.GetOk("introspection_config"); ok {
@@ -420,7 +420,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if v, ok := d.GetOk("additional_authentication_provider"); ok {
-			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
+			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(ctx))
 		}
 
 		if v, ok := d.GetOk("lambda_authorizer_config"); ok {
@@ -436,7 +436,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if v, ok := d.GetOk("user_pool_config"); ok {
-			input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).Region)
+			input.UserPoolConfig = expandGraphQLAPIUserPoolConfig(v.([]interface{}), meta.(*conns.AWSClient).ResourceRegion(ctx))
 		}
 
 		if v, ok := d.GetOk("introspection_config"); ok {
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_athena_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_athena_database", &resource.Sweeper{
 		Name: "aws_athena_database",
 		F:    sweepDatabases,
 	})
@@ -62,7 +62,7 @@ func resourceRegionSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "updating Backup Region Settings (%s): %s", d.Id(), err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 
 	return append(diags, resourceRegionSettingsRead(ctx, d, meta)...)
 }
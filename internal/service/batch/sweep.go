@@ -24,7 +24,7 @@ import (
 const propagationTimeout = 2 * time.Minute
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		F: sweepComputeEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
 		Dependencies: []string{
@@ -40,12 +40,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
 
-	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
 		Name: "aws_batch_scheduling_policy",
 		F:    sweepSchedulingPolicies,
 		Dependencies: []string{
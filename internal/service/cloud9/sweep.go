@@ -14,7 +14,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloud9_environment_ec2", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloud9_environment_ec2", &resource.Sweeper{
 		Name: "aws_cloud9_environment_ec2",
 		F:    sweepEnvironmentEC2s,
 	})
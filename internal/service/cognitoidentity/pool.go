@@ -187,7 +187,7 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Service:   "cognito-identity",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("identitypool/%s", d.Id()),
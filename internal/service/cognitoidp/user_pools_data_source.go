@@ -62,7 +62,7 @@ func dataSourceUserPoolsRead(ctx context.Context, d *schema.ResourceData, meta i
 		arn := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   cognitoidentityprovider.ServiceName,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Resource:  fmt.Sprintf("userpool/%s", userPoolID),
 		}.String()
@@ -47,7 +47,7 @@ func dataSourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "finding Connect Lambda Function Association by ARN (%s): not found", functionArn)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("function_arn", functionArn)
 	d.Set("instance_id", instanceID)
 
@@ -186,7 +186,7 @@ func resourceReportDefinitionRead(ctx context.Context, d *schema.ResourceData, m
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   names.CUR,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  "definition/" + reportName,
 	}.String()
@@ -116,7 +116,7 @@ func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			},
 			Timeout: time.Second * 10,
 		}
-		region := meta.(*conns.AWSClient).Region
+		region := meta.(*conns.AWSClient).ResourceRegion(ctx)
 
 		var requestURL string
 		if v, ok := d.GetOk("private_link_endpoint"); ok {
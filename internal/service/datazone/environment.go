@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment")
+func newEnvironmentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentResource{}
+
+	r.SetCompositeID(environmentResourceID)
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultUpdateTimeout(20 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type environmentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+	framework.WithTimeouts
+}
+
+func (*environmentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_datazone_environment"
+}
+
+func (r *environmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	userParameters := environmentParametersBlock(ctx)
+	userParameters.PlanModifiers = []planmodifier.List{
+		listplanmodifier.RequiresReplace(),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_account_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_blueprint_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_profile_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"glossary_terms": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"user_parameters": userParameters,
+		},
+	}
+}
+
+func (r *environmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.CreateEnvironmentInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateEnvironment(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataZone Environment (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	environment, err := waitEnvironmentCreated(ctx, conn, data.DomainIdentifier.ValueString(), data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("domain_identifier"), data.DomainIdentifier)
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DataZone Environment (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	userParameters := data.UserParameters
+	response.Diagnostics.Append(fwflex.Flatten(ctx, environment, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.UserParameters = userParameters
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *environmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	output, err := findEnvironmentByTwoPartKey(ctx, conn, data.DomainIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataZone Environment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The API returns the environment profile's parameter definitions, not the configured values.
+	userParameters := data.UserParameters
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.UserParameters = userParameters
+
+	data.EnvironmentProfileIdentifier = fwflex.StringToFramework(ctx, output.EnvironmentProfileId)
+	data.ProjectIdentifier = fwflex.StringToFramework(ctx, output.ProjectId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *environmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.GlossaryTerms.Equal(old.GlossaryTerms) ||
+		!new.Name.Equal(old.Name) {
+		input := &datazone.UpdateEnvironmentInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Identifier = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateEnvironment(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataZone Environment (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitEnvironmentUpdated(ctx, conn, new.DomainIdentifier.ValueString(), new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DataZone Environment (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *environmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	_, err := conn.DeleteEnvironment(ctx, &datazone.DeleteEnvironmentInput{
+		DomainIdentifier: aws.String(data.DomainIdentifier.ValueString()),
+		Identifier:       aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataZone Environment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, data.DomainIdentifier.ValueString(), data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DataZone Environment (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var environmentResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "domain_identifier", Placeholder: "DOMAIN_ID"},
+		{Name: names.AttrID, Placeholder: "ENVIRONMENT_ID"},
+	},
+}
+
+func findEnvironmentByTwoPartKey(ctx context.Context, conn *datazone.Client, domainID, environmentID string) (*datazone.GetEnvironmentOutput, error) {
+	input := &datazone.GetEnvironmentInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentID),
+	}
+
+	output, err := conn.GetEnvironment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.EnvironmentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusEnvironment(ctx context.Context, conn *datazone.Client, domainID, environmentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnvironmentByTwoPartKey(ctx, conn, domainID, environmentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *datazone.Client, domainID, environmentID string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusCreating),
+		Target:  enum.Slice(awstypes.EnvironmentStatusActive),
+		Refresh: statusEnvironment(ctx, conn, domainID, environmentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(ctx context.Context, conn *datazone.Client, domainID, environmentID string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusUpdating),
+		Target:  enum.Slice(awstypes.EnvironmentStatusActive),
+		Refresh: statusEnvironment(ctx, conn, domainID, environmentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *datazone.Client, domainID, environmentID string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusActive, awstypes.EnvironmentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusEnvironment(ctx, conn, domainID, environmentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type environmentResourceModel struct {
+	AWSAccountID                 types.String                                               `tfsdk:"aws_account_id"`
+	AWSAccountRegion             types.String                                               `tfsdk:"aws_account_region"`
+	CreatedAt                    timetypes.RFC3339                                          `tfsdk:"created_at"`
+	CreatedBy                    types.String                                               `tfsdk:"created_by"`
+	Description                  types.String                                               `tfsdk:"description"`
+	DomainIdentifier             types.String                                               `tfsdk:"domain_identifier"`
+	EnvironmentBlueprintID       types.String                                               `tfsdk:"environment_blueprint_id"`
+	EnvironmentProfileIdentifier types.String                                               `tfsdk:"environment_profile_identifier"`
+	GlossaryTerms                fwtypes.ListValueOf[types.String]                          `tfsdk:"glossary_terms"`
+	ID                           types.String                                               `tfsdk:"id"`
+	Name                         types.String                                               `tfsdk:"name"`
+	ProjectIdentifier            types.String                                               `tfsdk:"project_identifier"`
+	Timeouts                     timeouts.Value                                             `tfsdk:"timeouts"`
+	UserParameters               fwtypes.ListNestedObjectValueOf[environmentParameterModel] `tfsdk:"user_parameters"`
+}
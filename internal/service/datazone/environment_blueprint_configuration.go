@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment Blueprint Configuration")
+func newEnvironmentBlueprintConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentBlueprintConfigurationResource{}
+
+	r.SetCompositeID(environmentBlueprintConfigurationResourceID)
+
+	return r, nil
+}
+
+type environmentBlueprintConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+}
+
+func (*environmentBlueprintConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_datazone_environment_blueprint_configuration"
+}
+
+func (r *environmentBlueprintConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled_regions": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"environment_blueprint_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"manage_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"provisioning_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"regional_parameters": schema.MapAttribute{
+				// map[string]map[string]string
+				CustomType: fwtypes.NewMapTypeOf[fwtypes.MapValueOf[types.String]](ctx),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (r *environmentBlueprintConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentBlueprintConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input, diags := expandPutEnvironmentBlueprintConfigurationInput(ctx, data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id := environmentBlueprintConfigurationResourceID.Format(data.DomainID.ValueString(), data.EnvironmentBlueprintID.ValueString())
+
+	_, err := conn.PutEnvironmentBlueprintConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataZone Environment Blueprint Configuration (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *environmentBlueprintConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentBlueprintConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	output, err := findEnvironmentBlueprintConfigurationByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.EnvironmentBlueprintID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataZone Environment Blueprint Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *environmentBlueprintConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new environmentBlueprintConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input, diags := expandPutEnvironmentBlueprintConfigurationInput(ctx, new)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutEnvironmentBlueprintConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DataZone Environment Blueprint Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *environmentBlueprintConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentBlueprintConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	_, err := conn.DeleteEnvironmentBlueprintConfiguration(ctx, &datazone.DeleteEnvironmentBlueprintConfigurationInput{
+		DomainIdentifier:               aws.String(data.DomainID.ValueString()),
+		EnvironmentBlueprintIdentifier: aws.String(data.EnvironmentBlueprintID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataZone Environment Blueprint Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var environmentBlueprintConfigurationResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "domain_id"},
+		{Name: "environment_blueprint_id"},
+	},
+}
+
+func expandPutEnvironmentBlueprintConfigurationInput(ctx context.Context, data environmentBlueprintConfigurationResourceModel) (*datazone.PutEnvironmentBlueprintConfigurationInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &datazone.PutEnvironmentBlueprintConfigurationInput{}
+	diags.Append(fwflex.Expand(ctx, data, input)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Additional fields.
+	input.DomainIdentifier = aws.String(data.DomainID.ValueString())
+	input.EnvironmentBlueprintIdentifier = aws.String(data.EnvironmentBlueprintID.ValueString())
+
+	// AutoFlex does not expand maps of maps.
+	if !data.RegionalParameters.IsNull() {
+		var regionalParameters map[string]map[string]string
+		diags.Append(data.RegionalParameters.ElementsAs(ctx, &regionalParameters, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		input.RegionalParameters = regionalParameters
+	}
+
+	return input, diags
+}
+
+func findEnvironmentBlueprintConfigurationByTwoPartKey(ctx context.Context, conn *datazone.Client, domainID, environmentBlueprintID string) (*datazone.GetEnvironmentBlueprintConfigurationOutput, error) {
+	input := &datazone.GetEnvironmentBlueprintConfigurationInput{
+		DomainIdentifier:               aws.String(domainID),
+		EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
+	}
+
+	output, err := conn.GetEnvironmentBlueprintConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type environmentBlueprintConfigurationResourceModel struct {
+	DomainID               types.String                                         `tfsdk:"domain_id"`
+	EnabledRegions         fwtypes.ListValueOf[types.String]                    `tfsdk:"enabled_regions"`
+	EnvironmentBlueprintID types.String                                         `tfsdk:"environment_blueprint_id"`
+	ID                     types.String                                         `tfsdk:"id"`
+	ManageAccessRoleARN    fwtypes.ARN                                          `tfsdk:"manage_access_role_arn"`
+	ProvisioningRoleARN    fwtypes.ARN                                          `tfsdk:"provisioning_role_arn"`
+	RegionalParameters     fwtypes.MapValueOf[fwtypes.MapValueOf[types.String]] `tfsdk:"regional_parameters"`
+}
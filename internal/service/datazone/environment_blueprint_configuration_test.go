@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetEnvironmentBlueprintConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_datazone_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_regions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "enabled_regions.0", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_blueprint_id", "data.aws_datazone_environment_blueprint.test", "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "manage_access_role_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "provisioning_role_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetEnvironmentBlueprintConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEnvironmentBlueprintConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_roles(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetEnvironmentBlueprintConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "manage_access_role_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "provisioning_role_arn"),
+				),
+			},
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_roles(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "manage_access_role_arn", "aws_iam_role.manage_access", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_role_arn", "aws_iam_role.provisioning", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_environment_blueprint_configuration" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEnvironmentBlueprintConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["environment_blueprint_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Environment Blueprint Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentBlueprintConfigurationExists(ctx context.Context, n string, v *datazone.GetEnvironmentBlueprintConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindEnvironmentBlueprintConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["environment_blueprint_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), `
+data "aws_region" "current" {}
+
+data "aws_datazone_environment_blueprint" "test" {
+  domain_id = aws_datazone_domain.test.id
+  name      = "DefaultDataLake"
+  managed   = true
+}
+`)
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_base(rName), `
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = [data.aws_region.current.name]
+}
+`)
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_rolesBase(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "manage_access" {
+  name = "%[1]s-manage-access"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "datazone.amazonaws.com"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "manage_access" {
+  role       = aws_iam_role.manage_access.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonDataZoneGlueManageAccessRolePolicy"
+}
+
+resource "aws_iam_role" "provisioning" {
+  name = "%[1]s-provisioning"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "datazone.amazonaws.com"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "provisioning" {
+  role       = aws_iam_role.provisioning.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonDataZoneRedshiftGlueProvisioningPolicy"
+}
+`, rName))
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_roles(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_rolesBase(rName), `
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = [data.aws_region.current.name]
+  manage_access_role_arn   = aws_iam_role.manage_access.arn
+  provisioning_role_arn    = aws_iam_role.provisioning.arn
+
+  depends_on = [
+    aws_iam_role_policy_attachment.manage_access,
+    aws_iam_role_policy_attachment.provisioning,
+  ]
+}
+`)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Environment Blueprint")
+func newEnvironmentBlueprintDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &environmentBlueprintDataSource{}, nil
+}
+
+type environmentBlueprintDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*environmentBlueprintDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_datazone_environment_blueprint"
+}
+
+func (d *environmentBlueprintDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blueprint_provider": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"managed": schema.BoolAttribute{
+				Required: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *environmentBlueprintDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data environmentBlueprintDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DataZoneClient(ctx)
+
+	output, err := findEnvironmentBlueprintByName(ctx, conn, data.DomainID.ValueString(), data.Name.ValueString(), data.Managed.ValueBool())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataZone Environment Blueprint (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.BlueprintProvider = fwflex.StringToFramework(ctx, output.Provider)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findEnvironmentBlueprintByName(ctx context.Context, conn *datazone.Client, domainID, name string, managed bool) (*awstypes.EnvironmentBlueprintSummary, error) {
+	input := &datazone.ListEnvironmentBlueprintsInput{
+		DomainIdentifier: aws.String(domainID),
+		Managed:          aws.Bool(managed),
+		Name:             aws.String(name),
+	}
+
+	return findEnvironmentBlueprint(ctx, conn, input, func(v *awstypes.EnvironmentBlueprintSummary) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findEnvironmentBlueprint(ctx context.Context, conn *datazone.Client, input *datazone.ListEnvironmentBlueprintsInput, filter func(*awstypes.EnvironmentBlueprintSummary) bool) (*awstypes.EnvironmentBlueprintSummary, error) {
+	output, err := findEnvironmentBlueprints(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findEnvironmentBlueprints(ctx context.Context, conn *datazone.Client, input *datazone.ListEnvironmentBlueprintsInput, filter func(*awstypes.EnvironmentBlueprintSummary) bool) ([]*awstypes.EnvironmentBlueprintSummary, error) {
+	var output []*awstypes.EnvironmentBlueprintSummary
+
+	pages := datazone.NewListEnvironmentBlueprintsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			v := v
+			if v := &v; filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type environmentBlueprintDataSourceModel struct {
+	BlueprintProvider types.String `tfsdk:"blueprint_provider"`
+	Description       types.String `tfsdk:"description"`
+	DomainID          types.String `tfsdk:"domain_id"`
+	ID                types.String `tfsdk:"id"`
+	Managed           types.Bool   `tfsdk:"managed"`
+	Name              types.String `tfsdk:"name"`
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment Profile")
+func newEnvironmentProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentProfileResource{}
+
+	r.SetCompositeID(environmentProfileResourceID)
+
+	return r, nil
+}
+
+type environmentProfileResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+}
+
+func (*environmentProfileResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_datazone_environment_profile"
+}
+
+func (r *environmentProfileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Required: true,
+			},
+			"aws_account_region": schema.StringAttribute{
+				Required: true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_blueprint_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"user_parameters": environmentParametersBlock(ctx),
+		},
+	}
+}
+
+func (r *environmentProfileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.CreateEnvironmentProfileInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateEnvironmentProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataZone Environment Profile (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	userParameters := data.UserParameters
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.UserParameters = userParameters
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *environmentProfileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	output, err := findEnvironmentProfileByTwoPartKey(ctx, conn, data.DomainIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataZone Environment Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The API returns the blueprint's parameter definitions, not the configured values.
+	userParameters := data.UserParameters
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.UserParameters = userParameters
+
+	data.EnvironmentBlueprintIdentifier = fwflex.StringToFramework(ctx, output.EnvironmentBlueprintId)
+	data.ProjectIdentifier = fwflex.StringToFramework(ctx, output.ProjectId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *environmentProfileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new environmentProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.UpdateEnvironmentProfileInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Identifier = aws.String(new.ID.ValueString())
+
+	_, err := conn.UpdateEnvironmentProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DataZone Environment Profile (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *environmentProfileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	_, err := conn.DeleteEnvironmentProfile(ctx, &datazone.DeleteEnvironmentProfileInput{
+		DomainIdentifier: aws.String(data.DomainIdentifier.ValueString()),
+		Identifier:       aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataZone Environment Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var environmentProfileResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "domain_identifier", Placeholder: "DOMAIN_ID"},
+		{Name: names.AttrID, Placeholder: "ENVIRONMENT_PROFILE_ID"},
+	},
+}
+
+func findEnvironmentProfileByTwoPartKey(ctx context.Context, conn *datazone.Client, domainID, environmentProfileID string) (*datazone.GetEnvironmentProfileOutput, error) {
+	input := &datazone.GetEnvironmentProfileInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentProfileID),
+	}
+
+	output, err := conn.GetEnvironmentProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func environmentParametersBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[environmentParameterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				"value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+type environmentProfileResourceModel struct {
+	AWSAccountID                   types.String                                               `tfsdk:"aws_account_id"`
+	AWSAccountRegion               types.String                                               `tfsdk:"aws_account_region"`
+	CreatedAt                      timetypes.RFC3339                                          `tfsdk:"created_at"`
+	CreatedBy                      types.String                                               `tfsdk:"created_by"`
+	Description                    types.String                                               `tfsdk:"description"`
+	DomainIdentifier               types.String                                               `tfsdk:"domain_identifier"`
+	EnvironmentBlueprintIdentifier types.String                                               `tfsdk:"environment_blueprint_identifier"`
+	ID                             types.String                                               `tfsdk:"id"`
+	Name                           types.String                                               `tfsdk:"name"`
+	ProjectIdentifier              types.String                                               `tfsdk:"project_identifier"`
+	UserParameters                 fwtypes.ListNestedObjectValueOf[environmentParameterModel] `tfsdk:"user_parameters"`
+}
+
+type environmentParameterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneEnvironmentProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetEnvironmentProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_account_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_blueprint_identifier", "data.aws_datazone_environment_blueprint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project_identifier", "aws_datazone_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_parameters.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetEnvironmentProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEnvironmentProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetEnvironmentProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_description(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+				),
+			},
+			{
+				Config: testAccEnvironmentProfileConfig_description(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_environment_profile" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEnvironmentProfileByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Environment Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentProfileExists(ctx context.Context, n string, v *datazone.GetEnvironmentProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindEnvironmentProfileByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentProfileConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_datazone_project" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  name              = %[1]q
+}
+`, rName))
+}
+
+func testAccEnvironmentProfileConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_profile" "test" {
+  aws_account_id                   = data.aws_caller_identity.current.account_id
+  aws_account_region               = data.aws_region.current.name
+  domain_identifier                = aws_datazone_domain.test.id
+  environment_blueprint_identifier = aws_datazone_environment_blueprint_configuration.test.environment_blueprint_id
+  name                             = %[1]q
+  project_identifier               = aws_datazone_project.test.id
+}
+`, rName))
+}
+
+func testAccEnvironmentProfileConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccEnvironmentProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_profile" "test" {
+  aws_account_id                   = data.aws_caller_identity.current.account_id
+  aws_account_region               = data.aws_region.current.name
+  description                      = %[2]q
+  domain_identifier                = aws_datazone_domain.test.id
+  environment_blueprint_identifier = aws_datazone_environment_blueprint_configuration.test.environment_blueprint_id
+  name                             = %[1]q
+  project_identifier               = aws_datazone_project.test.id
+}
+`, rName, description))
+}
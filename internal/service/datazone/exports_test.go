@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+// Exports for use in tests only.
+var (
+	ResourceDomain                            = newDomainResource
+	ResourceEnvironment                       = newEnvironmentResource
+	ResourceEnvironmentBlueprintConfiguration = newEnvironmentBlueprintConfigurationResource
+	ResourceEnvironmentProfile                = newEnvironmentProfileResource
+	ResourceGlossary                          = newGlossaryResource
+	ResourceGlossaryTerm                      = newGlossaryTermResource
+	ResourceProject                           = newProjectResource
+
+	FindDomainByID                                    = findDomainByID
+	FindEnvironmentBlueprintConfigurationByTwoPartKey = findEnvironmentBlueprintConfigurationByTwoPartKey
+	FindEnvironmentByTwoPartKey                       = findEnvironmentByTwoPartKey
+	FindEnvironmentProfileByTwoPartKey                = findEnvironmentProfileByTwoPartKey
+	FindGlossaryByTwoPartKey                          = findGlossaryByTwoPartKey
+	FindGlossaryTermByTwoPartKey                      = findGlossaryTermByTwoPartKey
+	FindProjectByTwoPartKey                           = findProjectByTwoPartKey
+)
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Glossary")
+func newGlossaryResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &glossaryResource{}
+
+	r.SetCompositeID(glossaryResourceID)
+
+	return r, nil
+}
+
+type glossaryResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+}
+
+func (*glossaryResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_datazone_glossary"
+}
+
+func (r *glossaryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"owning_project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GlossaryStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *glossaryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data glossaryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.CreateGlossaryInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateGlossary(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataZone Glossary (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *glossaryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data glossaryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	output, err := findGlossaryByTwoPartKey(ctx, conn, data.DomainIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataZone Glossary (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.OwningProjectIdentifier = fwflex.StringToFramework(ctx, output.OwningProjectId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *glossaryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new glossaryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.UpdateGlossaryInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Identifier = aws.String(new.ID.ValueString())
+
+	_, err := conn.UpdateGlossary(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DataZone Glossary (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *glossaryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data glossaryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	// Enabled glossaries cannot be deleted.
+	if data.Status.ValueEnum() == awstypes.GlossaryStatusEnabled {
+		_, err := conn.UpdateGlossary(ctx, &datazone.UpdateGlossaryInput{
+			ClientToken:      aws.String(id.UniqueId()),
+			DomainIdentifier: aws.String(data.DomainIdentifier.ValueString()),
+			Identifier:       aws.String(data.ID.ValueString()),
+			Status:           awstypes.GlossaryStatusDisabled,
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("disabling DataZone Glossary (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	_, err := conn.DeleteGlossary(ctx, &datazone.DeleteGlossaryInput{
+		DomainIdentifier: aws.String(data.DomainIdentifier.ValueString()),
+		Identifier:       aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataZone Glossary (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var glossaryResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "domain_identifier", Placeholder: "DOMAIN_ID"},
+		{Name: names.AttrID, Placeholder: "GLOSSARY_ID"},
+	},
+}
+
+func findGlossaryByTwoPartKey(ctx context.Context, conn *datazone.Client, domainID, glossaryID string) (*datazone.GetGlossaryOutput, error) {
+	input := &datazone.GetGlossaryInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(glossaryID),
+	}
+
+	output, err := conn.GetGlossary(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type glossaryResourceModel struct {
+	Description             types.String                                `tfsdk:"description"`
+	DomainIdentifier        types.String                                `tfsdk:"domain_identifier"`
+	ID                      types.String                                `tfsdk:"id"`
+	Name                    types.String                                `tfsdk:"name"`
+	OwningProjectIdentifier types.String                                `tfsdk:"owning_project_identifier"`
+	Status                  fwtypes.StringEnum[awstypes.GlossaryStatus] `tfsdk:"status"`
+}
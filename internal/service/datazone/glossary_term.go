@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Glossary Term")
+func newGlossaryTermResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &glossaryTermResource{}
+
+	r.SetCompositeID(glossaryTermResourceID)
+
+	return r, nil
+}
+
+type glossaryTermResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+}
+
+func (*glossaryTermResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_datazone_glossary_term"
+}
+
+func (r *glossaryTermResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"glossary_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"long_description": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"short_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GlossaryTermStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"term_relations": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[termRelationsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"classifies": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"is_a": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *glossaryTermResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data glossaryTermResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.CreateGlossaryTermInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateGlossaryTerm(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataZone Glossary Term (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *glossaryTermResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data glossaryTermResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	output, err := findGlossaryTermByTwoPartKey(ctx, conn, data.DomainIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataZone Glossary Term (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.GlossaryIdentifier = fwflex.StringToFramework(ctx, output.GlossaryId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *glossaryTermResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new glossaryTermResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	input := &datazone.UpdateGlossaryTermInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Identifier = aws.String(new.ID.ValueString())
+
+	_, err := conn.UpdateGlossaryTerm(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DataZone Glossary Term (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *glossaryTermResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data glossaryTermResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataZoneClient(ctx)
+
+	_, err := conn.DeleteGlossaryTerm(ctx, &datazone.DeleteGlossaryTermInput{
+		DomainIdentifier: aws.String(data.DomainIdentifier.ValueString()),
+		Identifier:       aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataZone Glossary Term (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+var glossaryTermResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "domain_identifier", Placeholder: "DOMAIN_ID"},
+		{Name: names.AttrID, Placeholder: "GLOSSARY_TERM_ID"},
+	},
+}
+
+func findGlossaryTermByTwoPartKey(ctx context.Context, conn *datazone.Client, domainID, glossaryTermID string) (*datazone.GetGlossaryTermOutput, error) {
+	input := &datazone.GetGlossaryTermInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(glossaryTermID),
+	}
+
+	output, err := conn.GetGlossaryTerm(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type glossaryTermResourceModel struct {
+	DomainIdentifier   types.String                                        `tfsdk:"domain_identifier"`
+	GlossaryIdentifier types.String                                        `tfsdk:"glossary_identifier"`
+	ID                 types.String                                        `tfsdk:"id"`
+	LongDescription    types.String                                        `tfsdk:"long_description"`
+	Name               types.String                                        `tfsdk:"name"`
+	ShortDescription   types.String                                        `tfsdk:"short_description"`
+	Status             fwtypes.StringEnum[awstypes.GlossaryTermStatus]     `tfsdk:"status"`
+	TermRelations      fwtypes.ListNestedObjectValueOf[termRelationsModel] `tfsdk:"term_relations"`
+}
+
+type termRelationsModel struct {
+	Classifies fwtypes.ListValueOf[types.String] `tfsdk:"classifies"`
+	IsA        fwtypes.ListValueOf[types.String] `tfsdk:"is_a"`
+}
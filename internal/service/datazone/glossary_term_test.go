@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneGlossaryTerm_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetGlossaryTermOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary_term.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "glossary_identifier", "aws_datazone_glossary.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "term_relations.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossaryTerm_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetGlossaryTermOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary_term.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceGlossaryTerm, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossaryTerm_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetGlossaryTermOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary_term.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermConfig_descriptions(rName, "short 1", "long 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "long_description", "long 1"),
+					resource.TestCheckResourceAttr(resourceName, "short_description", "short 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlossaryTermConfig_descriptions(rName, "short 2", "long 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "long_description", "long 2"),
+					resource.TestCheckResourceAttr(resourceName, "short_description", "short 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlossaryTermDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_glossary_term" {
+				continue
+			}
+
+			_, err := tfdatazone.FindGlossaryTermByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Glossary Term %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlossaryTermExists(ctx context.Context, n string, v *datazone.GetGlossaryTermOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindGlossaryTermByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGlossaryTermConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGlossaryConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_glossary_term" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  glossary_identifier = aws_datazone_glossary.test.id
+  name                = %[1]q
+}
+`, rName))
+}
+
+func testAccGlossaryTermConfig_descriptions(rName, shortDescription, longDescription string) string {
+	return acctest.ConfigCompose(testAccGlossaryConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_glossary_term" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  glossary_identifier = aws_datazone_glossary.test.id
+  long_description    = %[3]q
+  name                = %[1]q
+  short_description   = %[2]q
+}
+`, rName, shortDescription, longDescription))
+}
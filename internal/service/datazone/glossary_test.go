@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneGlossary_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetGlossaryOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlossaryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "owning_project_identifier", "aws_datazone_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossary_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetGlossaryOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceGlossary, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossary_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datazone.GetGlossaryOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryConfig_update(rName, "description 1", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlossaryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlossaryConfig_update(rName, "description 2", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlossaryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlossaryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_glossary" {
+				continue
+			}
+
+			_, err := tfdatazone.FindGlossaryByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Glossary %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlossaryExists(ctx context.Context, n string, v *datazone.GetGlossaryOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindGlossaryByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGlossaryConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_glossary" "test" {
+  domain_identifier         = aws_datazone_domain.test.id
+  name                      = %[1]q
+  owning_project_identifier = aws_datazone_project.test.id
+}
+`, rName))
+}
+
+func testAccGlossaryConfig_update(rName, description, status string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_glossary" "test" {
+  description               = %[2]q
+  domain_identifier         = aws_datazone_domain.test.id
+  name                      = %[1]q
+  owning_project_identifier = aws_datazone_project.test.id
+  status                    = %[3]q
+}
+`, rName, description, status))
+}
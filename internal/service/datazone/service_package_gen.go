@@ -15,11 +15,48 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newEnvironmentBlueprintDataSource,
+			Name:    "Environment Blueprint",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newDomainResource,
+			Name:    "Domain",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newEnvironmentBlueprintConfigurationResource,
+			Name:    "Environment Blueprint Configuration",
+		},
+		{
+			Factory: newEnvironmentProfileResource,
+			Name:    "Environment Profile",
+		},
+		{
+			Factory: newEnvironmentResource,
+			Name:    "Environment",
+		},
+		{
+			Factory: newGlossaryResource,
+			Name:    "Glossary",
+		},
+		{
+			Factory: newGlossaryTermResource,
+			Name:    "Glossary Term",
+		},
+		{
+			Factory: newProjectResource,
+			Name:    "Project",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
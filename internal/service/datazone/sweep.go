@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("aws_datazone_domain", &resource.Sweeper{
+		Name: "aws_datazone_domain",
+		F:    sweepDomains,
+	})
+}
+
+func sweepDomains(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DataZoneClient(ctx)
+	input := &datazone.ListDomainsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := datazone.NewListDomainsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping DataZone Domain sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing DataZone Domains (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			// Deleting a domain deletes all of its projects, environments and glossaries.
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDomainResource, client,
+				framework.NewAttribute("id", aws.ToString(v.Id)),
+				framework.NewAttribute("skip_deletion_check", true),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DataZone Domains (%s): %w", region, err)
+	}
+
+	return nil
+}
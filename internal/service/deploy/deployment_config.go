@@ -180,7 +180,7 @@ func resourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, m
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "codedeploy",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  "deploymentconfig:" + deploymentConfigName,
 	}.String()
@@ -14,7 +14,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
 		Name: "aws_codedeploy_app",
 		F:    sweepApps,
 	})
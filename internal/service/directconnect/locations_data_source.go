@@ -45,7 +45,7 @@ func dataSourceLocationsRead(ctx context.Context, d *schema.ResourceData, meta i
 		locationCodes = append(locationCodes, location.LocationCode)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("location_codes", aws.StringValueSlice(locationCodes))
 
 	return diags
@@ -23,12 +23,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
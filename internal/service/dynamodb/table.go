@@ -1080,7 +1080,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 			var input = &dynamodb.UpdateReplicationGroupMemberAction{
 				KMSMasterKeyId: expandEncryptAtRestOptions(d.Get("server_side_encryption").([]interface{})).KMSMasterKeyId,
-				RegionName:     aws.String(meta.(*conns.AWSClient).Region),
+				RegionName:     aws.String(meta.(*conns.AWSClient).ResourceRegion(ctx)),
 			}
 			var update = &dynamodb.ReplicationGroupUpdate{Update: input}
 			replicaInputs = append(replicaInputs, update)
@@ -1875,7 +1875,7 @@ func clearSSEDefaultKey(ctx context.Context, client *conns.AWSClient, sseList []
 
 	sse := sseList[0].(map[string]interface{})
 
-	dk, err := kms.FindDefaultKey(ctx, client, "dynamodb", client.Region)
+	dk, err := kms.FindDefaultKey(ctx, client, "dynamodb", client.ResourceRegion(ctx))
 	if err != nil {
 		return sseList
 	}
@@ -85,7 +85,7 @@ func dataSourceEBSSnapshotIDsRead(ctx context.Context, d *schema.ResourceData, m
 		snapshotIDs = append(snapshotIDs, aws.StringValue(v.SnapshotId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", snapshotIDs)
 
 	return diags
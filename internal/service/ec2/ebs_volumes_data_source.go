@@ -67,7 +67,7 @@ func dataSourceEBSVolumesRead(ctx context.Context, d *schema.ResourceData, meta
 		volumeIDs = append(volumeIDs, aws.StringValue(v.VolumeId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", volumeIDs)
 
 	return diags
@@ -300,7 +300,7 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("architecture", image.Architecture)
 	imageArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Service:   ec2.ServiceName,
 		Resource:  fmt.Sprintf("image/%s", d.Id()),
 	}.String()
@@ -233,7 +233,7 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta interface
 	address := outputRaw.(*types.Address)
 	allocationID := aws.ToString(address.AllocationId)
 	d.Set("allocation_id", allocationID)
-	d.Set("arn", eipARN(meta.(*conns.AWSClient), allocationID))
+	d.Set("arn", eipARN(ctx, meta.(*conns.AWSClient), allocationID))
 	d.Set("association_id", address.AssociationId)
 	d.Set("carrier_ip", address.CarrierIp)
 	d.Set("customer_owned_ip", address.CustomerOwnedIp)
@@ -413,11 +413,11 @@ func disassociateEIP(ctx context.Context, conn *ec2.Client, associationID string
 	return nil
 }
 
-func eipARN(c *conns.AWSClient, allocationID string) string {
+func eipARN(ctx context.Context, c *conns.AWSClient, allocationID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   names.EC2,
-		Region:    c.Region,
+		Region:    c.ResourceRegion(ctx),
 		AccountID: c.AccountID,
 		Resource:  "elastic-ip/" + allocationID,
 	}.String()
@@ -798,7 +798,7 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("fleet/%s", d.Id()),
 	}.String()
@@ -81,7 +81,7 @@ func dataSourceInstanceTypeOfferingsRead(ctx context.Context, d *schema.Resource
 		locationTypes = append(locationTypes, aws.StringValue(instanceTypeOffering.LocationType))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("instance_types", instanceTypes)
 	d.Set("locations", locations)
 	d.Set("location_types", locationTypes)
@@ -113,7 +113,7 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", instanceIDs)
 	d.Set("ipv6_addresses", ipv6Addresses)
 	d.Set("private_ips", privateIPs)
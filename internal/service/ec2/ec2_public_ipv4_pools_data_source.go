@@ -62,7 +62,7 @@ func dataSourcePublicIPv4PoolsRead(ctx context.Context, d *schema.ResourceData,
 		poolIDs = append(poolIDs, aws.StringValue(v.PoolId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("pool_ids", poolIDs)
 
 	return diags
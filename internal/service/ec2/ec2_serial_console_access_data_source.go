@@ -42,7 +42,7 @@ func dataSourceSerialConsoleAccessRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Serial Console Access: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("enabled", output.SerialConsoleAccessEnabled)
 
 	return diags
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func FindAvailabilityZones(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAvailabilityZonesInput) ([]*ec2.AvailabilityZone, error) {
@@ -1794,13 +1796,15 @@ func FindMainRouteTableByVPCID(ctx context.Context, conn *ec2.EC2, vpcID string)
 }
 
 // FindRouteTableByID returns the route table corresponding to the specified identifier.
-// Returns NotFoundError if no route table is found.
+// During resource Read operations with the provider read cache enabled, the result is shared by all routes in the route table.
 func FindRouteTableByID(ctx context.Context, conn *ec2.EC2, routeTableID string) (*ec2.RouteTable, error) {
-	input := &ec2.DescribeRouteTablesInput{
-		RouteTableIds: aws.StringSlice([]string{routeTableID}),
-	}
+	return conns.ReadThrough(ctx, names.EC2, "RouteTable/"+routeTableID, func(ctx context.Context) (*ec2.RouteTable, error) {
+		input := &ec2.DescribeRouteTablesInput{
+			RouteTableIds: aws.StringSlice([]string{routeTableID}),
+		}
 
-	return FindRouteTable(ctx, conn, input)
+		return FindRouteTable(ctx, conn, input)
+	})
 }
 
 func FindRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) (*ec2.RouteTable, error) {
@@ -1909,25 +1913,29 @@ func FindRouteByPrefixListIDDestination(ctx context.Context, conn *ec2.EC2, rout
 	}
 }
 
+// FindSecurityGroupByID returns the security group corresponding to the specified identifier.
+// During resource Read operations with the provider read cache enabled, the result is shared by all rules in the security group.
 func FindSecurityGroupByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
-	input := &ec2.DescribeSecurityGroupsInput{
-		GroupIds: aws.StringSlice([]string{id}),
-	}
+	return conns.ReadThrough(ctx, names.EC2, "SecurityGroup/"+id, func(ctx context.Context) (*ec2.SecurityGroup, error) {
+		input := &ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice([]string{id}),
+		}
 
-	output, err := FindSecurityGroup(ctx, conn, input)
+		output, err := FindSecurityGroup(ctx, conn, input)
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	// Eventual consistency check.
-	if aws.StringValue(output.GroupId) != id {
-		return nil, &retry.NotFoundError{
-			LastRequest: input,
+		// Eventual consistency check.
+		if aws.StringValue(output.GroupId) != id {
+			return nil, &retry.NotFoundError{
+				LastRequest: input,
+			}
 		}
-	}
 
-	return output, nil
+		return output, nil
+	})
 }
 
 // FindSecurityGroupByNameAndVPCID looks up a security group by name, VPC ID. Returns a retry.NotFoundError if not found.
@@ -2093,14 +2101,18 @@ func FindSecurityGroupIngressRuleByID(ctx context.Context, conn *ec2.EC2, id str
 	return output, nil
 }
 
+// FindSecurityGroupRulesBySecurityGroupID returns the rules in the specified security group.
+// During resource Read operations with the provider read cache enabled, the result is shared by all rules in the security group.
 func FindSecurityGroupRulesBySecurityGroupID(ctx context.Context, conn *ec2.EC2, id string) ([]*ec2.SecurityGroupRule, error) {
-	input := &ec2.DescribeSecurityGroupRulesInput{
-		Filters: newAttributeFilterList(map[string]string{
-			"group-id": id,
-		}),
-	}
+	return conns.ReadThrough(ctx, names.EC2, "SecurityGroupRules/"+id, func(ctx context.Context) ([]*ec2.SecurityGroupRule, error) {
+		input := &ec2.DescribeSecurityGroupRulesInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"group-id": id,
+			}),
+		}
 
-	return FindSecurityGroupRules(ctx, conn, input)
+		return FindSecurityGroupRules(ctx, conn, input)
+	})
 }
 
 func FindSpotDatafeedSubscription(ctx context.Context, conn *ec2.EC2) (*ec2.SpotDatafeedSubscription, error) {
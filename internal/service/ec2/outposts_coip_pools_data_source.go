@@ -67,7 +67,7 @@ func dataSourceCoIPPoolsRead(ctx context.Context, d *schema.ResourceData, meta i
 		poolIDs = append(poolIDs, aws.StringValue(v.PoolId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("pool_ids", poolIDs)
 
 	return diags
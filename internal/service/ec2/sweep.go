@@ -21,7 +21,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -29,17 +29,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -47,17 +47,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -65,7 +65,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -73,12 +73,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -87,17 +87,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -105,7 +105,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -115,7 +115,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -123,7 +123,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -134,7 +134,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -143,17 +143,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -172,12 +172,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -189,12 +189,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -202,17 +202,17 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
 
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_subnet", &resource.Sweeper{
 		Name: "aws_subnet",
 		F:    sweepSubnets,
 		Dependencies: []string{
@@ -265,7 +265,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -273,12 +273,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -286,17 +286,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -307,12 +307,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -320,7 +320,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -329,12 +329,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -344,12 +344,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -357,12 +357,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -381,12 +381,12 @@ func RegisterSweepers() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -395,32 +395,32 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
 		Name: "aws_vpc_ipam_resource_discovery",
 		F:    sweepIPAMResourceDiscoveries,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -428,7 +428,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -436,7 +436,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -444,12 +444,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
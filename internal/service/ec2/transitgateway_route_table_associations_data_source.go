@@ -71,7 +71,7 @@ func dataSourceTransitGatewayRouteTableAssociationsRead(ctx context.Context, d *
 		routeTableAssociationIDs = append(routeTableAssociationIDs, aws.StringValue(v.TransitGatewayAttachmentId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", routeTableAssociationIDs)
 
 	return diags
@@ -71,7 +71,7 @@ func dataSourceTransitGatewayRouteTablePropagationsRead(ctx context.Context, d *
 		routeTablePropagationIDs = append(routeTablePropagationIDs, aws.StringValue(v.TransitGatewayAttachmentId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", routeTablePropagationIDs)
 
 	return diags
@@ -67,7 +67,7 @@ func dataSourceTransitGatewayRouteTablesRead(ctx context.Context, d *schema.Reso
 		routeTableIDs = append(routeTableIDs, aws.StringValue(v.TransitGatewayRouteTableId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", routeTableIDs)
 
 	return diags
@@ -167,7 +167,7 @@ func dataSourceVPCRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   names.EC2,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: aws.ToString(ownerID),
 		Resource:  "vpc/" + d.Id(),
 	}.String()
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -16,7 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
 )
 
 // @SDKResource("aws_ec2_managed_prefix_list_entry")
@@ -27,9 +28,7 @@ func ResourceManagedPrefixListEntry() *schema.Resource {
 		ReadWithoutTimeout:   resourceManagedPrefixListEntryRead,
 		DeleteWithoutTimeout: resourceManagedPrefixListEntryDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceManagedPrefixListEntryImport,
-		},
+		Importer: sdkv2.ImportByCompositeID(managedPrefixListEntryID),
 
 		Schema: map[string]*schema.Schema{
 			"cidr": {
@@ -178,34 +177,22 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
-func resourceManagedPrefixListEntryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	plID, cidr, err := ManagedPrefixListEntryParseResourceID(d.Id())
-
-	if err != nil {
-		return nil, err
-	}
-
-	d.Set("cidr", cidr)
-	d.Set("prefix_list_id", plID)
-
-	return []*schema.ResourceData{d}, nil
+var managedPrefixListEntryID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "prefix_list_id", Placeholder: "prefix-list-id"},
+		{Name: "cidr", Placeholder: "cidr-block"},
+	},
 }
 
-const managedPrefixListEntryIDSeparator = ","
-
 func ManagedPrefixListEntryCreateResourceID(prefixListID, cidrBlock string) string {
-	parts := []string{prefixListID, cidrBlock}
-	id := strings.Join(parts, managedPrefixListEntryIDSeparator)
-
-	return id
+	return managedPrefixListEntryID.Format(prefixListID, cidrBlock)
 }
 
 func ManagedPrefixListEntryParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, managedPrefixListEntryIDSeparator)
-
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	parts, err := managedPrefixListEntryID.Parse(id)
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected prefix-list-id%[2]scidr-block", id, managedPrefixListEntryIDSeparator)
+	return parts[0], parts[1], nil
 }
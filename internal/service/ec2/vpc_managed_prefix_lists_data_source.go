@@ -64,7 +64,7 @@ func dataSourceManagedPrefixListsRead(ctx context.Context, d *schema.ResourceDat
 		prefixListIDs = append(prefixListIDs, aws.StringValue(v.PrefixListId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", prefixListIDs)
 
 	return diags
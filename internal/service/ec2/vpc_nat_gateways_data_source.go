@@ -82,7 +82,7 @@ func dataSourceNATGatewaysRead(ctx context.Context, d *schema.ResourceData, meta
 		natGatewayIDs = append(natGatewayIDs, aws.StringValue(v.NatGatewayId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", natGatewayIDs)
 
 	return diags
@@ -79,7 +79,7 @@ func dataSourceNetworkACLsRead(ctx context.Context, d *schema.ResourceData, meta
 		naclIDs = append(naclIDs, aws.StringValue(v.NetworkAclId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", naclIDs)
 
 	return diags
@@ -79,7 +79,7 @@ func dataSourceRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta
 		routeTableIDs = append(routeTableIDs, aws.StringValue(v.RouteTableId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", routeTableIDs)
 
 	return diags
@@ -80,7 +80,7 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		arn := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   ec2.ServiceName,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 			AccountID: aws.StringValue(v.OwnerId),
 			Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(v.GroupId)),
 		}.String()
@@ -89,7 +89,7 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		vpcIDs = append(vpcIDs, aws.StringValue(v.VpcId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", arns)
 	d.Set("ids", securityGroupIDs)
 	d.Set("vpc_ids", vpcIDs)
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
 		Name: "aws_ecr_repository",
 		F:    sweepRepositories,
 	})
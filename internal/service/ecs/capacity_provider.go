@@ -265,7 +265,7 @@ func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   "ecs",
 		Resource:  fmt.Sprintf("capacity-provider/%s", d.Id()),
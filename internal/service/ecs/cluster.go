@@ -150,7 +150,7 @@ func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("name", d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   "ecs",
 		Resource:  fmt.Sprintf("cluster/%s", d.Id()),
@@ -45,7 +45,7 @@ func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta in
 		clusters = append(clusters, page.Clusters...)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("names", clusters)
 
 	return diags
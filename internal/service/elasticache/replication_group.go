@@ -941,7 +941,7 @@ func resourceReplicationGroupDelete(ctx context.Context, d *schema.ResourceData,
 	v, hasGlobalReplicationGroupID := d.GetOk("global_replication_group_id")
 	if hasGlobalReplicationGroupID {
 		globalReplicationGroupID := v.(string)
-		err := DisassociateReplicationGroup(ctx, conn, globalReplicationGroupID, d.Id(), meta.(*conns.AWSClient).Region, GlobalReplicationGroupDisassociationReadyTimeout)
+		err := DisassociateReplicationGroup(ctx, conn, globalReplicationGroupID, d.Id(), meta.(*conns.AWSClient).ResourceRegion(ctx), GlobalReplicationGroupDisassociationReadyTimeout)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "disassociating ElastiCache Replication Group (%s) from Global Replication Group (%s): %s", d.Id(), globalReplicationGroupID, err)
 		}
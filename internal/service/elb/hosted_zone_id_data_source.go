@@ -67,7 +67,7 @@ func DataSourceHostedZoneID() *schema.Resource {
 
 func dataSourceHostedZoneIDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).ResourceRegion(ctx)
 	if v, ok := d.GetOk("region"); ok {
 		region = v.(string)
 	}
@@ -78,7 +78,7 @@ func dataSourceLoadBalancersRead(ctx context.Context, d *schema.ResourceData, me
 		loadBalancerARNs = append(loadBalancerARNs, aws.StringValue(lb.LoadBalancerArn))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", loadBalancerARNs)
 
 	return diags
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// StateMoves returns the moves of resource state, via Terraform `moved` blocks, supported by this service package.
+// Each `aws_alb*` resource type is an alias of the corresponding `aws_lb*` resource type.
+func (p *servicePackage) StateMoves(context.Context) []*framework.StateMove {
+	var moves []*framework.StateMove
+
+	for _, v := range []string{
+		"",
+		"_listener",
+		"_listener_certificate",
+		"_listener_rule",
+		"_target_group",
+		"_target_group_attachment",
+	} {
+		moves = append(moves, &framework.StateMove{
+			SourceTypeName: "aws_alb" + v,
+			TargetTypeName: "aws_lb" + v,
+			CopyAttributes: true,
+		})
+	}
+
+	return moves
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -41,12 +41,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
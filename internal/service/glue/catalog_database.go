@@ -273,7 +273,7 @@ func resourceCatalogDatabaseRead(ctx context.Context, d *schema.ResourceData, me
 	databaseArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("database/%s", aws.StringValue(database.Name)),
 	}.String()
@@ -498,7 +498,7 @@ func resourceCrawlerRead(ctx context.Context, d *schema.ResourceData, meta inter
 	crawlerARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("crawler/%s", d.Id()),
 	}.String()
@@ -75,7 +75,7 @@ func resourceResourcePolicyPut(condition string) func(context.Context, *schema.R
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "putting policy request: %s", err)
 		}
-		d.SetId(meta.(*conns.AWSClient).Region)
+		d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 
 		return append(diags, resourceResourcePolicyRead(ctx, d, meta)...)
 	}
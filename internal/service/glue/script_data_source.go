@@ -129,7 +129,7 @@ func dataSourceScriptRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "script not created")
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("python_script", output.PythonScript)
 	d.Set("scala_code", output.ScalaCode)
 
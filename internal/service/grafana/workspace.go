@@ -284,7 +284,7 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta int
 	workspaceARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   managedgrafana.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("/workspaces/%s", d.Id()),
 	}.String()
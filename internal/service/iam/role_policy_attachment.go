@@ -22,6 +22,7 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_iam_role_policy_attachment", name="Role Policy Attachment")
@@ -158,23 +159,29 @@ func detachPolicyFromRole(ctx context.Context, conn *iam.Client, role, policyARN
 }
 
 func findAttachedRolePolicyByTwoPartKey(ctx context.Context, conn *iam.Client, roleName, policyARN string) (*awstypes.AttachedPolicy, error) {
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(roleName),
+	output, err := findAttachedRolePoliciesByRoleName(ctx, conn, roleName)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return findAttachedRolePolicy(ctx, conn, input, func(v awstypes.AttachedPolicy) bool {
+	output = tfslices.Filter(output, func(v awstypes.AttachedPolicy) bool {
 		return aws.ToString(v.PolicyArn) == policyARN
 	})
-}
 
-func findAttachedRolePolicy(ctx context.Context, conn *iam.Client, input *iam.ListAttachedRolePoliciesInput, filter tfslices.Predicate[awstypes.AttachedPolicy]) (*awstypes.AttachedPolicy, error) {
-	output, err := findAttachedRolePolicies(ctx, conn, input, filter)
+	return tfresource.AssertSingleValueResult(output)
+}
 
-	if err != nil {
-		return nil, err
-	}
+// findAttachedRolePoliciesByRoleName returns all the managed policies attached to the specified role.
+// During resource Read operations with the provider read cache enabled, the result is shared by all attachments to the role.
+func findAttachedRolePoliciesByRoleName(ctx context.Context, conn *iam.Client, roleName string) ([]awstypes.AttachedPolicy, error) {
+	return conns.ReadThrough(ctx, names.IAM, "AttachedRolePolicies/"+roleName, func(ctx context.Context) ([]awstypes.AttachedPolicy, error) {
+		input := &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(roleName),
+		}
 
-	return tfresource.AssertSingleValueResult(output)
+		return findAttachedRolePolicies(ctx, conn, input, tfslices.PredicateTrue[awstypes.AttachedPolicy]())
+	})
 }
 
 func findAttachedRolePolicies(ctx context.Context, conn *iam.Client, input *iam.ListAttachedRolePoliciesInput, filter tfslices.Predicate[awstypes.AttachedPolicy]) ([]awstypes.AttachedPolicy, error) {
@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
@@ -49,6 +51,44 @@ func TestAccIAMRolePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccIAMRolePolicy_movedFromRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rolePolicy string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.IAMServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyConfig_roleInlinePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_role.test", "inline_policy.#", "1"),
+				),
+			},
+			{
+				Config: testAccRolePolicyConfig_movedFromRole(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyExists(ctx, resourceName, &rolePolicy),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.role", "name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMRolePolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rolePolicy string
@@ -318,6 +358,81 @@ EOF
 `, rName)
 }
 
+func testAccRolePolicyConfig_roleInlinePolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+    }]
+  })
+
+  inline_policy {
+    name = %[1]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = "ec2:Describe*"
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+}
+`, rName)
+}
+
+func testAccRolePolicyConfig_movedFromRole(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.role.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+moved {
+  from = aws_iam_role.test
+  to   = aws_iam_role_policy.test
+}
+
+import {
+  to = aws_iam_role.role
+  id = %[1]q
+}
+`, rName)
+}
+
 func testAccRolePolicyConfig_nameGenerated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// StateMoves returns the moves of resource state, via Terraform `moved` blocks, supported by this service package.
+//
+// Moving an `aws_iam_role` resource's single `inline_policy` to an `aws_iam_role_policy` resource
+// removes the role from state. Terraform doesn't allow a `moved` block's `from` address to remain in configuration,
+// so the role, without the `inline_policy`, is imported again at a new address:
+//
+//	resource "aws_iam_role" "example_role" {
+//	  name = "example-role"
+//	  ...
+//	}
+//
+//	resource "aws_iam_role_policy" "example" {
+//	  role = aws_iam_role.example_role.name
+//	  ...
+//	}
+//
+//	moved {
+//	  from = aws_iam_role.example
+//	  to   = aws_iam_role_policy.example
+//	}
+//
+//	import {
+//	  to = aws_iam_role.example_role
+//	  id = "example-role"
+//	}
+func (p *servicePackage) StateMoves(context.Context) []*framework.StateMove {
+	return []*framework.StateMove{
+		{
+			SourceTypeName: "aws_iam_role",
+			TargetTypeName: "aws_iam_role_policy",
+			Attributes: map[string]string{
+				"name":   "inline_policy.0.name",
+				"policy": "inline_policy.0.policy",
+				"role":   "name",
+			},
+			Required: []string{"inline_policy.0.name"},
+			Transform: func(_ context.Context, source, target map[string]any) error {
+				if v, _ := framework.AttributeAtPath(source, "inline_policy"); len(v.([]any)) > 1 {
+					return fmt.Errorf("role (%s) has %d inline policies, only a role with a single inline policy can be moved. Use `import` blocks for roles with multiple inline policies", target["role"], len(v.([]any)))
+				}
+
+				target["id"] = fmt.Sprintf("%s:%s", target["role"], target["name"])
+
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestStateMoveRoleToRolePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	targetType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":          tftypes.String,
+			"name":        tftypes.String,
+			"name_prefix": tftypes.String,
+			"policy":      tftypes.String,
+			"role":        tftypes.String,
+		},
+	}
+
+	var move *framework.StateMove
+	for _, v := range tfiam.ServicePackage(ctx).(interface {
+		StateMoves(context.Context) []*framework.StateMove
+	}).StateMoves(ctx) {
+		if v.SourceTypeName == "aws_iam_role" && v.TargetTypeName == "aws_iam_role_policy" {
+			move = v
+		}
+	}
+	if move == nil {
+		t.Fatal("aws_iam_role to aws_iam_role_policy move not found")
+	}
+
+	testCases := []struct {
+		name        string
+		sourceJSON  string
+		expected    tftypes.Value
+		expectError bool
+	}{
+		{
+			name:       "single inline policy",
+			sourceJSON: `{"id":"example","name":"example","inline_policy":[{"name":"policy1","policy":"{}"}]}`,
+			expected: tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, "example:policy1"),
+				"name":        tftypes.NewValue(tftypes.String, "policy1"),
+				"name_prefix": tftypes.NewValue(tftypes.String, nil),
+				"policy":      tftypes.NewValue(tftypes.String, "{}"),
+				"role":        tftypes.NewValue(tftypes.String, "example"),
+			}),
+		},
+		{
+			name:        "no inline policy",
+			sourceJSON:  `{"id":"example","name":"example","inline_policy":[]}`,
+			expectError: true,
+		},
+		{
+			name:        "multiple inline policies",
+			sourceJSON:  `{"id":"example","name":"example","inline_policy":[{"name":"policy1","policy":"{}"},{"name":"policy2","policy":"{}"}]}`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := move.Move(ctx, 0, []byte(testCase.sourceJSON), targetType)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err == nil && !got.Equal(testCase.expected) {
+				t.Errorf("target state = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
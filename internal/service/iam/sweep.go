@@ -28,7 +28,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 
 	sweep.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -91,14 +91,14 @@ func RegisterSweepers() {
 
 	sweep.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	sweep.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
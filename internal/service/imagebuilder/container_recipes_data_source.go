@@ -84,7 +84,7 @@ func dataSourceContainerRecipesRead(ctx context.Context, d *schema.ResourceData,
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
@@ -74,7 +74,7 @@ func dataSourceImagePipelinesRead(ctx context.Context, d *schema.ResourceData, m
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
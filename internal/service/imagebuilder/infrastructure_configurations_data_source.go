@@ -74,7 +74,7 @@ func dataSourceInfrastructureConfigurationsRead(ctx context.Context, d *schema.R
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
@@ -43,7 +43,7 @@ func dataSourceRulesPackagesRead(ctx context.Context, d *schema.ResourceData, me
 	arns := aws.StringValueSlice(output)
 	sort.Strings(arns)
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", arns)
 
 	return diags
@@ -59,11 +59,11 @@ func resourceEventConfigurationsPut(ctx context.Context, d *schema.ResourceData,
 	_, err := conn.UpdateEventConfigurationsWithContext(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating IoT Event Configurations (%s): %s", meta.(*conns.AWSClient).Region, err)
+		return sdkdiag.AppendErrorf(diags, "updating IoT Event Configurations (%s): %s", meta.(*conns.AWSClient).ResourceRegion(ctx), err)
 	}
 
 	if d.IsNewResource() {
-		d.SetId(meta.(*conns.AWSClient).Region)
+		d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	}
 
 	return append(diags, resourceEventConfigurationsRead(ctx, d, meta)...)
@@ -181,7 +181,7 @@ func resourceQuerySuggestionsBlockListRead(ctx context.Context, d *schema.Resour
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Service:   "kendra",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("index/%s/query-suggestions-block-list/%s", indexId, id),
@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_kinesis_analytics_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_analytics_application", &resource.Sweeper{
 		Name: "aws_kinesis_analytics_application",
 		F:    sweepApplications,
 	})
@@ -126,7 +126,7 @@ func resourceReplicaExternalKeyCreate(ctx context.Context, d *schema.ResourceDat
 
 	input := &kms.ReplicateKeyInput{
 		KeyId:         aws.String(strings.TrimPrefix(primaryKeyARN.Resource, "key/")),
-		ReplicaRegion: aws.String(meta.(*conns.AWSClient).Region),
+		ReplicaRegion: aws.String(meta.(*conns.AWSClient).ResourceRegion(ctx)),
 		Tags:          getTagsIn(ctx),
 	}
 
@@ -114,7 +114,7 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	input := &kms.ReplicateKeyInput{
 		KeyId:         aws.String(strings.TrimPrefix(primaryKeyARN.Resource, "key/")),
-		ReplicaRegion: aws.String(meta.(*conns.AWSClient).Region),
+		ReplicaRegion: aws.String(meta.(*conns.AWSClient).ResourceRegion(ctx)),
 		Tags:          getTagsIn(ctx),
 	}
 
@@ -76,7 +76,7 @@ func dataSourceAliasRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("description", aliasConfiguration.Description)
 	d.Set("function_version", aliasConfiguration.FunctionVersion)
 
-	invokeArn := functionInvokeARN(*aliasConfiguration.AliasArn, meta)
+	invokeArn := functionInvokeARN(ctx, *aliasConfiguration.AliasArn, meta)
 	d.Set("invoke_arn", invokeArn)
 
 	return diags
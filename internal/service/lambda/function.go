@@ -679,7 +679,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if output.Code != nil {
 		d.Set("image_uri", output.Code.ImageUri)
 	}
-	d.Set("invoke_arn", functionInvokeARN(functionARN, meta))
+	d.Set("invoke_arn", functionInvokeARN(ctx, functionARN, meta))
 	d.Set("kms_key_arn", function.KMSKeyArn)
 	d.Set("last_modified", function.LastModified)
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
@@ -724,7 +724,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
-		d.Set("qualified_invoke_arn", functionInvokeARN(functionARN, meta))
+		d.Set("qualified_invoke_arn", functionInvokeARN(ctx, functionARN, meta))
 		d.Set("version", function.Version)
 	} else {
 		latest, err := findLatestFunctionVersionByName(ctx, conn, d.Id())
@@ -735,7 +735,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 		qualifiedARN := aws.ToString(latest.FunctionArn)
 		d.Set("qualified_arn", qualifiedARN)
-		d.Set("qualified_invoke_arn", functionInvokeARN(qualifiedARN, meta))
+		d.Set("qualified_invoke_arn", functionInvokeARN(ctx, qualifiedARN, meta))
 		d.Set("version", latest.Version)
 
 		setTagsOut(ctx, output.Tags)
@@ -746,7 +746,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
 	// Currently this functionality is not enabled in all Regions and returns ambiguous error codes
 	// (e.g. AccessDeniedException), so we cannot just ignore the error as we would typically.
-	if partition := meta.(*conns.AWSClient).Partition; partition == endpoints.AwsPartitionID && SignerServiceIsAvailable(meta.(*conns.AWSClient).Region) {
+	if partition := meta.(*conns.AWSClient).Partition; partition == endpoints.AwsPartitionID && SignerServiceIsAvailable(meta.(*conns.AWSClient).ResourceRegion(ctx)) {
 		var codeSigningConfigArn string
 
 		// Code Signing is only supported on zip packaged lambda functions.
@@ -1327,11 +1327,11 @@ func readFileContents(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
-func functionInvokeARN(functionARN string, meta interface{}) string {
+func functionInvokeARN(ctx context.Context, functionARN string, meta interface{}) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "apigateway",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: "lambda",
 		Resource:  fmt.Sprintf("path/2015-03-31/functions/%s/invocations", functionARN),
 	}.String()
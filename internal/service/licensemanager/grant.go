@@ -106,7 +106,7 @@ func resourceGrantCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		AllowedOperations: aws.StringSlice(expandAllowedOperations(d.Get("allowed_operations").(*schema.Set).List())),
 		ClientToken:       aws.String(id.UniqueId()),
 		GrantName:         aws.String(d.Get("name").(string)),
-		HomeRegion:        aws.String(meta.(*conns.AWSClient).Region),
+		HomeRegion:        aws.String(meta.(*conns.AWSClient).ResourceRegion(ctx)),
 		LicenseArn:        aws.String(d.Get("license_arn").(string)),
 		Principals:        aws.StringSlice([]string{d.Get("principal").(string)}),
 	}
@@ -73,7 +73,7 @@ func dataSourceTrackerAssociationsRead(ctx context.Context, d *schema.ResourceDa
 		return create.AppendDiagError(diags, names.Location, create.ErrActionReading, DSNameTrackerAssociations, name, err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("consumer_arns", arns)
 
 	return diags
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// policyTypeFieldIndexPolicy is not yet modeled by the AWS SDK's PolicyType enum.
+const policyTypeFieldIndexPolicy types.PolicyType = "FIELD_INDEX_POLICY"
+
+func accountPolicyType_Values() []string {
+	return append(enum.Values[types.PolicyType](), string(policyTypeFieldIndexPolicy))
+}
+
+var accountPolicyID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "policy_name"},
+		{Name: "policy_type", Validators: []compositeid.Validator{compositeid.OneOf(accountPolicyType_Values()...)}},
+	},
+}
+
+// @SDKResource("aws_cloudwatch_log_account_policy", name="Account Policy")
+func resourceAccountPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountPolicyPut,
+		ReadWithoutTimeout:   resourceAccountPolicyRead,
+		UpdateWithoutTimeout: resourceAccountPolicyPut,
+		DeleteWithoutTimeout: resourceAccountPolicyDelete,
+
+		Importer: sdkv2.ImportByCompositeID(accountPolicyID),
+
+		Schema: map[string]*schema.Schema{
+			"policy_document": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(accountPolicyType_Values(), false),
+			},
+			"scope": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.ScopeAll,
+				ValidateDiagFunc: enum.Validate[types.Scope](),
+			},
+			"selection_criteria": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAccountPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policy, err)
+	}
+
+	name, policyType := d.Get("policy_name").(string), types.PolicyType(d.Get("policy_type").(string))
+	input := &cloudwatchlogs.PutAccountPolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyName:     aws.String(name),
+		PolicyType:     policyType,
+		Scope:          types.Scope(d.Get("scope").(string)),
+	}
+
+	if v, ok := d.GetOk("selection_criteria"); ok {
+		input.SelectionCriteria = aws.String(v.(string))
+	}
+
+	_, err = conn.PutAccountPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CloudWatch Logs Account Policy (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(accountPolicyID.Format(name, string(policyType)))
+	}
+
+	return append(diags, resourceAccountPolicyRead(ctx, d, meta)...)
+}
+
+func resourceAccountPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	parts, err := accountPolicyID.Parse(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findAccountPolicyByTwoPartKey(ctx, conn, types.PolicyType(parts[1]), parts[0])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Account Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Account Policy (%s): %s", d.Id(), err)
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), aws.ToString(output.PolicyDocument))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("policy_document", policyToSet)
+	d.Set("policy_name", output.PolicyName)
+	d.Set("policy_type", output.PolicyType)
+	d.Set("scope", output.Scope)
+	d.Set("selection_criteria", output.SelectionCriteria)
+
+	return diags
+}
+
+func resourceAccountPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Account Policy: %s", d.Id())
+	_, err := conn.DeleteAccountPolicy(ctx, &cloudwatchlogs.DeleteAccountPolicyInput{
+		PolicyName: aws.String(d.Get("policy_name").(string)),
+		PolicyType: types.PolicyType(d.Get("policy_type").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Account Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAccountPolicyByTwoPartKey(ctx context.Context, conn *cloudwatchlogs.Client, policyType types.PolicyType, name string) (*types.AccountPolicy, error) {
+	input := &cloudwatchlogs.DescribeAccountPoliciesInput{
+		PolicyName: aws.String(name),
+		PolicyType: policyType,
+	}
+
+	output, err := conn.DescribeAccountPolicies(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.AccountPolicies)
+}
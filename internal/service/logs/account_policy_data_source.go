@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudwatch_log_account_policy", name="Account Policy")
+func dataSourceAccountPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccountPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accountPolicyType_Values(), false),
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"selection_criteria": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAccountPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	name, policyType := d.Get("policy_name").(string), d.Get("policy_type").(string)
+	id := accountPolicyID.Format(name, policyType)
+	policy, err := findAccountPolicyByTwoPartKey(ctx, conn, types.PolicyType(policyType), name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Account Policy (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("account_id", policy.AccountId)
+	d.Set("last_updated_time", policy.LastUpdatedTime)
+	d.Set("policy_document", policy.PolicyDocument)
+	d.Set("scope", policy.Scope)
+	d.Set("selection_criteria", policy.SelectionCriteria)
+
+	return diags
+}
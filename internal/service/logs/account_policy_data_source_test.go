@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsAccountPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_account_policy.test"
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_updated_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_document"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_name", resourceName, "policy_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_type", resourceName, "policy_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scope", resourceName, "scope"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountPolicyDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccountPolicyConfig_dataProtection(rName, "EmailAddress"), `
+data "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = aws_cloudwatch_log_account_policy.test.policy_name
+  policy_type = aws_cloudwatch_log_account_policy.test.policy_type
+}
+`)
+}
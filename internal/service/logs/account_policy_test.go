@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Account policies are account-wide, so these tests must not run in parallel.
+
+func TestAccLogsAccountPolicy_dataProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.AccountPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_dataProtection(rName, "EmailAddress"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "DATA_PROTECTION_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "ALL"),
+					resource.TestCheckNoResourceAttr(resourceName, "selection_criteria"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName + ",DATA_PROTECTION_POLICY",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountPolicyConfig_dataProtection(rName, "Address"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsAccountPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.AccountPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_dataProtection(rName, "EmailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceAccountPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAccountPolicy_subscriptionFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.AccountPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_subscriptionFilter(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "SUBSCRIPTION_FILTER_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "selection_criteria", fmt.Sprintf("LogGroupName NOT IN [\"%s\"]", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName + ",SUBSCRIPTION_FILTER_POLICY",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountPolicyConfig_subscriptionFilter(rName, rName+"-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "selection_criteria", fmt.Sprintf("LogGroupName NOT IN [\"%s-updated\"]", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsAccountPolicy_fieldIndex(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.AccountPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_fieldIndex(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "FIELD_INDEX_POLICY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName + ",FIELD_INDEX_POLICY",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_account_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, types.PolicyType(rs.Primary.Attributes["policy_type"]), rs.Primary.Attributes["policy_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Account Policy still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountPolicyExists(ctx context.Context, n string, v *types.AccountPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, types.PolicyType(rs.Primary.Attributes["policy_type"]), rs.Primary.Attributes["policy_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAccountPolicyConfig_dataProtection(rName, dataIdentifier string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "DATA_PROTECTION_POLICY"
+  policy_document = jsonencode({
+    Name    = "Test"
+    Version = "2021-06-01"
+
+    Statement = [
+      {
+        Sid            = "Audit"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/%[2]s"]
+        Operation = {
+          Audit = {
+            FindingsDestination = {}
+          }
+        }
+      },
+      {
+        Sid            = "Redact"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/%[2]s"]
+        Operation = {
+          Deidentify = {
+            MaskConfig = {}
+          }
+        }
+      }
+    ]
+  })
+}
+`, rName, dataIdentifier)
+}
+
+func testAccAccountPolicyConfig_subscriptionFilter(rName, excludedLogGroupName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "logs.${data.aws_region.current.name}.amazonaws.com"
+      }
+      Condition = {
+        StringLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "kinesis:PutRecord"
+      Effect   = "Allow"
+      Resource = aws_kinesis_stream.test.arn
+    }]
+  })
+}
+
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "SUBSCRIPTION_FILTER_POLICY"
+  policy_document = jsonencode({
+    DestinationArn = aws_kinesis_stream.test.arn
+    FilterPattern  = "test"
+    RoleArn        = aws_iam_role.test.arn
+  })
+  selection_criteria = "LogGroupName NOT IN [\"%[2]s\"]"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, excludedLogGroupName)
+}
+
+func testAccAccountPolicyConfig_fieldIndex(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "FIELD_INDEX_POLICY"
+  policy_document = jsonencode({
+    Fields = ["eventName", "requestId"]
+  })
+}
+`, rName)
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+func newAnomalyDetectorResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &anomalyDetectorResource{}
+
+	return r, nil
+}
+
+type anomalyDetectorResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*anomalyDetectorResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_anomaly_detector"
+}
+
+func (r *anomalyDetectorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"anomaly_visibility_time": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(7, 90),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"detector_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Required: true,
+			},
+			"evaluation_frequency": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationFrequency](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter_pattern": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_group_arn_list": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfARNType,
+				ElementType: fwtypes.ARNType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *anomalyDetectorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	input := &cloudwatchlogs.CreateLogAnomalyDetectorInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLogAnomalyDetector(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudWatch Logs Anomaly Detector", err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.AnomalyDetectorArn)
+	data.setID()
+
+	// Anomaly detectors are created enabled.
+	if !data.Enabled.ValueBool() {
+		if err := updateAnomalyDetector(ctx, conn, &data); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("disabling CloudWatch Logs Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	detector, err := findAnomalyDetectorByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AnomalyVisibilityTime = fwflex.Int64ToFramework(ctx, detector.AnomalyVisibilityTime)
+	data.DetectorName = fwflex.StringToFramework(ctx, detector.DetectorName)
+	data.EvaluationFrequency = fwtypes.StringEnumValue(detector.EvaluationFrequency)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *anomalyDetectorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	output, err := findAnomalyDetectorByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Enabled = types.BoolValue(output.AnomalyDetectorStatus != awstypes.AnomalyDetectorStatusPaused)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *anomalyDetectorResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	if !new.AnomalyVisibilityTime.Equal(old.AnomalyVisibilityTime) ||
+		!new.Enabled.Equal(old.Enabled) ||
+		!new.EvaluationFrequency.Equal(old.EvaluationFrequency) ||
+		!new.FilterPattern.Equal(old.FilterPattern) {
+		if err := updateAnomalyDetector(ctx, conn, &new); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Anomaly Detector (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *anomalyDetectorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	_, err := conn.DeleteLogAnomalyDetector(ctx, &cloudwatchlogs.DeleteLogAnomalyDetectorInput{
+		AnomalyDetectorArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *anomalyDetectorResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func updateAnomalyDetector(ctx context.Context, conn *cloudwatchlogs.Client, data *anomalyDetectorResourceModel) error {
+	input := &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+		AnomalyDetectorArn:    fwflex.StringFromFramework(ctx, data.ID),
+		AnomalyVisibilityTime: fwflex.Int64FromFramework(ctx, data.AnomalyVisibilityTime),
+		Enabled:               fwflex.BoolFromFramework(ctx, data.Enabled),
+		EvaluationFrequency:   data.EvaluationFrequency.ValueEnum(),
+		FilterPattern:         fwflex.StringFromFramework(ctx, data.FilterPattern),
+	}
+
+	_, err := conn.UpdateLogAnomalyDetector(ctx, input)
+
+	return err
+}
+
+func findAnomalyDetectorByARN(ctx context.Context, conn *cloudwatchlogs.Client, arn string) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
+	input := &cloudwatchlogs.GetLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLogAnomalyDetector(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.AnomalyDetectorStatus == awstypes.AnomalyDetectorStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(output.AnomalyDetectorStatus),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type anomalyDetectorResourceModel struct {
+	AnomalyVisibilityTime types.Int64                                      `tfsdk:"anomaly_visibility_time"`
+	ARN                   types.String                                     `tfsdk:"arn"`
+	DetectorName          types.String                                     `tfsdk:"detector_name"`
+	Enabled               types.Bool                                       `tfsdk:"enabled"`
+	EvaluationFrequency   fwtypes.StringEnum[awstypes.EvaluationFrequency] `tfsdk:"evaluation_frequency"`
+	FilterPattern         types.String                                     `tfsdk:"filter_pattern"`
+	ID                    types.String                                     `tfsdk:"id"`
+	KMSKeyID              types.String                                     `tfsdk:"kms_key_id"`
+	LogGroupARNList       fwtypes.ListValueOf[fwtypes.ARN]                 `tfsdk:"log_group_arn_list"`
+	Tags                  types.Map                                        `tfsdk:"tags"`
+	TagsAll               types.Map                                        `tfsdk:"tags_all"`
+}
+
+func (m *anomalyDetectorResourceModel) InitFromID() error {
+	m.ARN = m.ID
+
+	return nil
+}
+
+func (m *anomalyDetectorResourceModel) setID() {
+	m.ID = m.ARN
+}
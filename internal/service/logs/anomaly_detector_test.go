@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsAnomalyDetector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+	logGroupResourceName := "aws_cloudwatch_log_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "anomaly_visibility_time"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "logs", regexache.MustCompile(`anomaly-detector:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "detector_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "evaluation_frequency"),
+					resource.TestCheckNoResourceAttr(resourceName, "filter_pattern"),
+					resource.TestCheckNoResourceAttr(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "log_group_arn_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_arn_list.0", logGroupResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAnomalyDetector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflogs.ResourceAnomalyDetector, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAnomalyDetector_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_full(rName, "ONE_HOUR", 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_visibility_time", "14"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_frequency", "ONE_HOUR"),
+					resource.TestCheckResourceAttr(resourceName, "filter_pattern", "ERROR"),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_full(rName, "THIRTY_MIN", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_visibility_time", "30"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_frequency", "THIRTY_MIN"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsAnomalyDetector_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_anomaly_detector" {
+				continue
+			}
+
+			_, err := tflogs.FindAnomalyDetectorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Anomaly Detector still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalyDetectorExists(ctx context.Context, n string, v *cloudwatchlogs.GetLogAnomalyDetectorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindAnomalyDetectorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAnomalyDetectorConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_basic(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name      = %[1]q
+  enabled            = %[2]t
+  log_group_arn_list = [aws_cloudwatch_log_group.test.arn]
+}
+`, rName, enabled))
+}
+
+func testAccAnomalyDetectorConfig_full(rName, evaluationFrequency string, anomalyVisibilityTime int) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name           = %[1]q
+  enabled                 = true
+  log_group_arn_list      = [aws_cloudwatch_log_group.test.arn]
+  anomaly_visibility_time = %[3]d
+  evaluation_frequency    = %[2]q
+  filter_pattern          = "ERROR"
+}
+`, rName, evaluationFrequency, anomalyVisibilityTime))
+}
+
+func testAccAnomalyDetectorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name      = %[1]q
+  enabled            = true
+  log_group_arn_list = [aws_cloudwatch_log_group.test.arn]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAnomalyDetectorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name      = %[1]q
+  enabled            = true
+  log_group_arn_list = [aws_cloudwatch_log_group.test.arn]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
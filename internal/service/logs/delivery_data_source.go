@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Delivery")
+func newDeliveryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &deliveryDataSource{}
+
+	return d, nil
+}
+
+type deliveryDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*deliveryDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_delivery"
+}
+
+func (d *deliveryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"delivery_destination_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			"delivery_destination_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DeliveryDestinationType](),
+				Computed:   true,
+			},
+			"delivery_source_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *deliveryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deliveryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	output, err := findDeliveryByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Delivery (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type deliveryDataSourceModel struct {
+	ARN                     types.String                                         `tfsdk:"arn"`
+	DeliveryDestinationARN  fwtypes.ARN                                          `tfsdk:"delivery_destination_arn"`
+	DeliveryDestinationType fwtypes.StringEnum[awstypes.DeliveryDestinationType] `tfsdk:"delivery_destination_type"`
+	DeliverySourceName      types.String                                         `tfsdk:"delivery_source_name"`
+	ID                      types.String                                         `tfsdk:"id"`
+	Tags                    types.Map                                            `tfsdk:"tags"`
+}
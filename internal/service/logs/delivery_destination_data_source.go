@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Delivery Destination")
+func newDeliveryDestinationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &deliveryDestinationDataSource{}
+
+	return d, nil
+}
+
+type deliveryDestinationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*deliveryDestinationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_delivery_destination"
+}
+
+func (d *deliveryDestinationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"delivery_destination_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[deliveryDestinationConfigurationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[deliveryDestinationConfigurationModel](ctx),
+			},
+			"delivery_destination_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DeliveryDestinationType](),
+				Computed:   true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"output_format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OutputFormat](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *deliveryDestinationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deliveryDestinationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	output, err := findDeliveryDestinationByName(ctx, conn, data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Delivery Destination (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Name)
+	data.Tags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type deliveryDestinationDataSourceModel struct {
+	ARN                              types.String                                                           `tfsdk:"arn"`
+	DeliveryDestinationConfiguration fwtypes.ListNestedObjectValueOf[deliveryDestinationConfigurationModel] `tfsdk:"delivery_destination_configuration"`
+	DeliveryDestinationType          fwtypes.StringEnum[awstypes.DeliveryDestinationType]                   `tfsdk:"delivery_destination_type"`
+	ID                               types.String                                                           `tfsdk:"id"`
+	Name                             types.String                                                           `tfsdk:"name"`
+	OutputFormat                     fwtypes.StringEnum[awstypes.OutputFormat]                              `tfsdk:"output_format"`
+	Tags                             types.Map                                                              `tfsdk:"tags"`
+}
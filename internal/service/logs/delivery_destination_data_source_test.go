@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsDeliveryDestinationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_delivery_destination.test"
+	resourceName := "aws_cloudwatch_log_delivery_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "delivery_destination_configuration.#", resourceName, "delivery_destination_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "delivery_destination_configuration.0.destination_resource_arn", resourceName, "delivery_destination_configuration.0.destination_resource_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "delivery_destination_type", resourceName, "delivery_destination_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_format", resourceName, "output_format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeliveryDestinationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeliveryDestinationConfig_basic(rName, 0), `
+data "aws_cloudwatch_log_delivery_destination" "test" {
+  name = aws_cloudwatch_log_delivery_destination.test.name
+}
+`)
+}
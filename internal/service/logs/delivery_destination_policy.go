@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Delivery Destination Policy")
+func newDeliveryDestinationPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &deliveryDestinationPolicyResource{}
+
+	return r, nil
+}
+
+type deliveryDestinationPolicyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*deliveryDestinationPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_delivery_destination_policy"
+}
+
+func (r *deliveryDestinationPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"delivery_destination_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delivery_destination_policy": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *deliveryDestinationPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data deliveryDestinationPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	input := &cloudwatchlogs.PutDeliveryDestinationPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutDeliveryDestinationPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Logs Delivery Destination Policy (%s)", data.DeliveryDestinationName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *deliveryDestinationPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data deliveryDestinationPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	output, err := findDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, data.DeliveryDestinationName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Delivery Destination Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *deliveryDestinationPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new deliveryDestinationPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	input := &cloudwatchlogs.PutDeliveryDestinationPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutDeliveryDestinationPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Delivery Destination Policy (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *deliveryDestinationPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data deliveryDestinationPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	_, err := conn.DeleteDeliveryDestinationPolicy(ctx, &cloudwatchlogs.DeleteDeliveryDestinationPolicyInput{
+		DeliveryDestinationName: fwflex.StringFromFramework(ctx, data.DeliveryDestinationName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Delivery Destination Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findDeliveryDestinationPolicyByDeliveryDestinationName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*awstypes.Policy, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationPolicyInput{
+		DeliveryDestinationName: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestinationPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil || output.Policy.DeliveryDestinationPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
+
+type deliveryDestinationPolicyResourceModel struct {
+	DeliveryDestinationName   types.String      `tfsdk:"delivery_destination_name"`
+	DeliveryDestinationPolicy fwtypes.IAMPolicy `tfsdk:"delivery_destination_policy"`
+	ID                        types.String      `tfsdk:"id"`
+}
+
+func (m *deliveryDestinationPolicyResourceModel) InitFromID() error {
+	m.DeliveryDestinationName = m.ID
+
+	return nil
+}
+
+func (m *deliveryDestinationPolicyResourceModel) setID() {
+	m.ID = m.DeliveryDestinationName
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsDeliveryDestinationPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_delivery_destination_policy.test"
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "delivery_destination_name", resourceName, "delivery_destination_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "delivery_destination_policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeliveryDestinationPolicyDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"), `
+data "aws_cloudwatch_log_delivery_destination_policy" "test" {
+  delivery_destination_name = aws_cloudwatch_log_delivery_destination_policy.test.delivery_destination_name
+}
+`)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsDeliveryDestinationPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestinationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delivery_destination_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "delivery_destination_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsDeliveryDestinationPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestinationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflogs.ResourceDeliveryDestinationPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsDeliveryDestinationPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestinationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeliveryDestinationPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_delivery_destination_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Delivery Destination Policy still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeliveryDestinationPolicyExists(ctx context.Context, n string, v *awstypes.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDeliveryDestinationPolicyConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccDeliveryDestinationConfig_basic(rName, 0), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_delivery_destination_policy" "test" {
+  delivery_destination_name = aws_cloudwatch_log_delivery_destination.test.name
+  delivery_destination_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowLogDeliveryActions"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = %[1]q
+      Resource = aws_cloudwatch_log_delivery_destination.test.arn
+    }]
+  })
+}
+`, action))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Delivery Source")
+func newDeliverySourceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &deliverySourceDataSource{}
+
+	return d, nil
+}
+
+type deliverySourceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*deliverySourceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_delivery_source"
+}
+
+func (d *deliverySourceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"log_type": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"resource_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			"service": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *deliverySourceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deliverySourceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	output, err := findDeliverySourceByName(ctx, conn, data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Delivery Source (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Name)
+	if len(output.ResourceArns) > 0 {
+		data.ResourceARN = fwtypes.ARNValueMust(output.ResourceArns[0])
+	}
+	data.Tags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type deliverySourceDataSourceModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	ID          types.String `tfsdk:"id"`
+	LogType     types.String `tfsdk:"log_type"`
+	Name        types.String `tfsdk:"name"`
+	ResourceARN fwtypes.ARN  `tfsdk:"resource_arn"`
+	Service     types.String `tfsdk:"service"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
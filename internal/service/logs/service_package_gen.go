@@ -15,15 +15,70 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDeliveryDataSource,
+			Name:    "Delivery",
+		},
+		{
+			Factory: newDeliveryDestinationDataSource,
+			Name:    "Delivery Destination",
+		},
+		{
+			Factory: newDeliveryDestinationPolicyDataSource,
+			Name:    "Delivery Destination Policy",
+		},
+		{
+			Factory: newDeliverySourceDataSource,
+			Name:    "Delivery Source",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAnomalyDetectorResource,
+			Name:    "Anomaly Detector",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newDeliveryResource,
+			Name:    "Delivery",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newDeliveryDestinationResource,
+			Name:    "Delivery Destination",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory: newDeliveryDestinationPolicyResource,
+			Name:    "Delivery Destination Policy",
+		},
+		{
+			Factory: newDeliverySourceResource,
+			Name:    "Delivery Source",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccountPolicy,
+			TypeName: "aws_cloudwatch_log_account_policy",
+			Name:     "Account Policy",
+		},
 		{
 			Factory:  dataSourceDataProtectionPolicyDocument,
 			TypeName: "aws_cloudwatch_log_data_protection_policy_document",
@@ -41,6 +96,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAccountPolicy,
+			TypeName: "aws_cloudwatch_log_account_policy",
+			Name:     "Account Policy",
+		},
 		{
 			Factory:  resourceDataProtectionPolicy,
 			TypeName: "aws_cloudwatch_log_data_protection_policy",
@@ -12,10 +12,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_anomaly_detector", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_anomaly_detector",
+		F:    sweepAnomalyDetectors,
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_delivery", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery",
+		F:    sweepDeliveries,
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_delivery_destination", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_destination",
+		F:    sweepDeliveryDestinations,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_delivery_source", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_source",
+		F:    sweepDeliverySources,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,17 +69,175 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
 }
 
+func sweepAnomalyDetectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.ListLogAnomalyDetectorsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewListLogAnomalyDetectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Anomaly Detector sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAnomalyDetectorResource, client,
+				framework.NewAttribute("id", aws.ToString(v.AnomalyDetectorArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveries(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveriesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Deliveries (%s): %w", region, err)
+		}
+
+		for _, v := range page.Deliveries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryResource, client,
+				framework.NewAttribute("id", aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Deliveries (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveryDestinations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveryDestinationsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveryDestinationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliveryDestinations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryDestinationResource, client,
+				framework.NewAttribute("id", aws.ToString(v.Name)),
+				framework.NewAttribute("name", aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliverySources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliverySourcesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliverySourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Sources (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliverySources {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliverySourceResource, client,
+				framework.NewAttribute("id", aws.ToString(v.Name)),
+				framework.NewAttribute("name", aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
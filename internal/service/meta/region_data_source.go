@@ -101,7 +101,7 @@ func (d *dataSourceRegion) Read(ctx context.Context, request datasource.ReadRequ
 
 	// Default to provider current region if no other filters matched
 	if region == nil {
-		matchingRegion, err := FindRegionByName(d.Meta().Region)
+		matchingRegion, err := FindRegionByName(d.Meta().ResourceRegion(ctx))
 
 		if err != nil {
 			response.Diagnostics.AddError("finding Region by name", err.Error())
@@ -115,7 +115,7 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 	}
 
 	if data.Region.IsNull() {
-		data.Region = types.StringValue(d.Meta().Region)
+		data.Region = types.StringValue(d.Meta().ResourceRegion(ctx))
 	}
 
 	if data.ServiceID.IsNull() {
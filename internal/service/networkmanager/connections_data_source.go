@@ -72,7 +72,7 @@ func dataSourceConnectionsRead(ctx context.Context, d *schema.ResourceData, meta
 		connectionIDs = append(connectionIDs, aws.StringValue(v.ConnectionId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", connectionIDs)
 
 	return diags
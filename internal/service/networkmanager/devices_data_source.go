@@ -72,7 +72,7 @@ func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, meta int
 		deviceIDs = append(deviceIDs, aws.StringValue(v.DeviceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", deviceIDs)
 
 	return diags
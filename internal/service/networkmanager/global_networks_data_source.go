@@ -56,7 +56,7 @@ func dataSourceGlobalNetworksRead(ctx context.Context, d *schema.ResourceData, m
 		globalNetworkIDs = append(globalNetworkIDs, aws.StringValue(v.GlobalNetworkId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", globalNetworkIDs)
 
 	return diags
@@ -88,7 +88,7 @@ func dataSourceLinksRead(ctx context.Context, d *schema.ResourceData, meta inter
 		linkIDs = append(linkIDs, aws.StringValue(v.LinkId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", linkIDs)
 
 	return diags
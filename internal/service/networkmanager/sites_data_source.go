@@ -62,7 +62,7 @@ func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		siteIDs = append(siteIDs, aws.StringValue(v.SiteId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", siteIDs)
 
 	return diags
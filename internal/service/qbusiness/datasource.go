@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Source")
+// @Tags(identifierAttribute="arn")
+func newDataSourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataSourceResource{}
+
+	r.SetCompositeID(dataSourceResourceID)
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type dataSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+	framework.WithTimeouts
+}
+
+func (*dataSourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_datasource"
+}
+
+func (r *dataSourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"configuration": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"sync_schedule": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vpc_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceVPCConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"subnet_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dataSourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateDataSourceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	configuration, diags := expandDataSourceConfiguration(data.Configuration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Configuration = configuration
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataSource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Q Business Data Source (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DataSourceARN = fwflex.StringToFramework(ctx, output.DataSourceArn)
+	data.DataSourceID = fwflex.StringToFramework(ctx, output.DataSourceId)
+
+	dataSource, err := waitDataSourceCreated(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("application_id"), data.ApplicationID)
+		response.State.SetAttribute(ctx, path.Root("index_id"), data.IndexID)
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.DataSourceID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Q Business Data Source (%s) create", data.DataSourceID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Type = fwflex.StringToFramework(ctx, dataSource.Type)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataSourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findDataSourceByThreePartKey(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Q Business Data Source (%s)", data.DataSourceID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataSourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new dataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) ||
+		!new.Description.Equal(old.Description) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.RoleARN.Equal(old.RoleARN) ||
+		!new.SyncSchedule.Equal(old.SyncSchedule) ||
+		!new.VPCConfiguration.Equal(old.VPCConfiguration) {
+		input := &qbusiness.UpdateDataSourceInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		configuration, diags := expandDataSourceConfiguration(new.Configuration)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Configuration = configuration
+
+		_, err := conn.UpdateDataSource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Q Business Data Source (%s)", new.DataSourceID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitDataSourceUpdated(ctx, conn, new.ApplicationID.ValueString(), new.IndexID.ValueString(), new.DataSourceID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Q Business Data Source (%s) update", new.DataSourceID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataSourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeleteDataSource(ctx, &qbusiness.DeleteDataSourceInput{
+		ApplicationId: aws.String(data.ApplicationID.ValueString()),
+		DataSourceId:  aws.String(data.DataSourceID.ValueString()),
+		IndexId:       aws.String(data.IndexID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Q Business Data Source (%s)", data.DataSourceID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDataSourceDeleted(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Q Business Data Source (%s) delete", data.DataSourceID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *dataSourceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+var dataSourceResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "application_id", Placeholder: "APPLICATION_ID"},
+		{Name: "index_id", Placeholder: "INDEX_ID"},
+		{Name: names.AttrID, Placeholder: "DATA_SOURCE_ID"},
+	},
+}
+
+func findDataSourceByThreePartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) (*qbusiness.GetDataSourceOutput, error) {
+	input := &qbusiness.GetDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetDataSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataSource(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataSourceByThreePartKey(ctx, conn, applicationID, indexID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSourceCreated(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusPendingCreation, awstypes.DataSourceStatusCreating),
+		Target:  enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh: statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		setErrorDetail(err, output.Error)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusUpdating),
+		Target:  enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh: statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		setErrorDetail(err, output.Error)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusActive, awstypes.DataSourceStatusDeleting),
+		Target:  []string{},
+		Refresh: statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		setErrorDetail(err, output.Error)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandDataSourceConfiguration converts a JSON data source configuration to a Smithy document.
+// AutoFlex can flatten Smithy documents but not expand them.
+func expandDataSourceConfiguration(v jsontypes.Normalized) (document.Interface, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, err := tfjson.SmithyDocumentFromString(v.ValueString(), document.NewLazyDocument)
+
+	if err != nil {
+		diags.AddAttributeError(path.Root("configuration"), "Invalid JSON", err.Error())
+	}
+
+	return configuration, diags
+}
+
+type dataSourceResourceModel struct {
+	ApplicationID    types.String                                                     `tfsdk:"application_id"`
+	Configuration    jsontypes.Normalized                                             `tfsdk:"configuration"`
+	DataSourceARN    types.String                                                     `tfsdk:"arn"`
+	DataSourceID     types.String                                                     `tfsdk:"id"`
+	Description      types.String                                                     `tfsdk:"description"`
+	DisplayName      types.String                                                     `tfsdk:"display_name"`
+	IndexID          types.String                                                     `tfsdk:"index_id"`
+	RoleARN          fwtypes.ARN                                                      `tfsdk:"role_arn"`
+	SyncSchedule     types.String                                                     `tfsdk:"sync_schedule"`
+	Tags             types.Map                                                        `tfsdk:"tags"`
+	TagsAll          types.Map                                                        `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                                                   `tfsdk:"timeouts"`
+	Type             types.String                                                     `tfsdk:"type"`
+	VPCConfiguration fwtypes.ListNestedObjectValueOf[dataSourceVPCConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+type dataSourceVPCConfigurationModel struct {
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_ids"`
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_datasource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", "id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "qbusiness", regexache.MustCompile(`application/.+/index/.+/data-source/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_qbusiness_index.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.datasource", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "S3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccDataSourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration", "timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccQBusinessDataSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_datasource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceDataSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_datasource" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindDataSourceByThreePartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Q Business Data Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataSourceExists(ctx context.Context, n string, v *qbusiness.GetDataSourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindDataSourceByThreePartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataSourceImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "datasource" {
+  name = "%[1]s-datasource"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qbusiness.${data.aws_partition.current.dns_suffix}"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "datasource" {
+  name = "%[1]s-datasource"
+  role = aws_iam_role.datasource.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }, {
+      Action   = ["qbusiness:BatchPutDocument", "qbusiness:BatchDeleteDocument"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_qbusiness_datasource" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+  index_id       = aws_qbusiness_index.test.id
+  role_arn       = aws_iam_role.datasource.arn
+
+  configuration = jsonencode({
+    type     = "S3"
+    syncMode = "FULL_CRAWL"
+
+    connectionConfiguration = {
+      repositoryEndpointMetadata = {
+        BucketName = aws_s3_bucket.test.bucket
+      }
+    }
+
+    repositoryConfigurations = {
+      document = {
+        fieldMappings = [{
+          dataSourceFieldName = "s3_document_id"
+          indexFieldName      = "s3_document_id"
+          indexFieldType      = "STRING"
+        }]
+      }
+    }
+  })
+
+  depends_on = [aws_iam_role_policy.datasource]
+}
+`, rName))
+}
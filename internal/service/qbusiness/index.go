@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Index")
+// @Tags(identifierAttribute="arn")
+func newIndexResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &indexResource{}
+
+	r.SetCompositeID(indexResourceID)
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type indexResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+	framework.WithTimeouts
+}
+
+func (*indexResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_index"
+}
+
+func (r *indexResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[indexCapacityConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"units": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *indexResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data indexResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateIndexInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateIndex(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Q Business Index (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IndexARN = fwflex.StringToFramework(ctx, output.IndexArn)
+	data.IndexID = fwflex.StringToFramework(ctx, output.IndexId)
+
+	if _, err := waitIndexCreated(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("application_id"), data.ApplicationID)
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.IndexID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Q Business Index (%s) create", data.IndexID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *indexResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data indexResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findIndexByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Q Business Index (%s)", data.IndexID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *indexResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new indexResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.CapacityConfiguration.Equal(old.CapacityConfiguration) ||
+		!new.Description.Equal(old.Description) ||
+		!new.DisplayName.Equal(old.DisplayName) {
+		input := &qbusiness.UpdateIndexInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateIndex(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Q Business Index (%s)", new.IndexID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitIndexUpdated(ctx, conn, new.ApplicationID.ValueString(), new.IndexID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Q Business Index (%s) update", new.IndexID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *indexResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data indexResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeleteIndex(ctx, &qbusiness.DeleteIndexInput{
+		ApplicationId: aws.String(data.ApplicationID.ValueString()),
+		IndexId:       aws.String(data.IndexID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Q Business Index (%s)", data.IndexID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitIndexDeleted(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Q Business Index (%s) delete", data.IndexID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *indexResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+var indexResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "application_id", Placeholder: "APPLICATION_ID"},
+		{Name: names.AttrID, Placeholder: "INDEX_ID"},
+	},
+}
+
+func findIndexByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string) (*qbusiness.GetIndexOutput, error) {
+	input := &qbusiness.GetIndexInput{
+		ApplicationId: aws.String(applicationID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetIndex(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIndex(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIndexByTwoPartKey(ctx, conn, applicationID, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIndexCreated(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IndexStatusCreating),
+		Target:  enum.Slice(awstypes.IndexStatusActive),
+		Refresh: statusIndex(ctx, conn, applicationID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		setErrorDetail(err, output.Error)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IndexStatusUpdating),
+		Target:  enum.Slice(awstypes.IndexStatusActive),
+		Refresh: statusIndex(ctx, conn, applicationID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		setErrorDetail(err, output.Error)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IndexStatusActive, awstypes.IndexStatusDeleting),
+		Target:  []string{},
+		Refresh: statusIndex(ctx, conn, applicationID, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		setErrorDetail(err, output.Error)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type indexResourceModel struct {
+	ApplicationID         types.String                                                     `tfsdk:"application_id"`
+	CapacityConfiguration fwtypes.ListNestedObjectValueOf[indexCapacityConfigurationModel] `tfsdk:"capacity_configuration"`
+	Description           types.String                                                     `tfsdk:"description"`
+	DisplayName           types.String                                                     `tfsdk:"display_name"`
+	IndexARN              types.String                                                     `tfsdk:"arn"`
+	IndexID               types.String                                                     `tfsdk:"id"`
+	Tags                  types.Map                                                        `tfsdk:"tags"`
+	TagsAll               types.Map                                                        `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type indexCapacityConfigurationModel struct {
+	Units types.Int64 `tfsdk:"units"`
+}
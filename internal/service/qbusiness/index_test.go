@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessIndex_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetIndexOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", "id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "qbusiness", regexache.MustCompile(`application/.+/index/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccApplicationChildImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccQBusinessIndex_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetIndexOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceIndex, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQBusinessIndex_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetIndexOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_update(rName, "description 1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_configuration.0.units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+				),
+			},
+			{
+				Config: testAccIndexConfig_update(rName, "description 2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_configuration.0.units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIndexDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_index" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindIndexByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Q Business Index %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIndexExists(ctx context.Context, n string, v *qbusiness.GetIndexOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindIndexByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIndexConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_qbusiness_index" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+}
+`, rName))
+}
+
+func testAccIndexConfig_update(rName, description string, units int) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_qbusiness_index" "test" {
+  application_id = aws_qbusiness_application.test.id
+  description    = %[2]q
+  display_name   = %[1]q
+
+  capacity_configuration {
+    units = %[3]d
+  }
+}
+`, rName, description, units))
+}
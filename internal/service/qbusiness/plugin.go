@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newPluginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetCompositeID(pluginResourceID)
+
+	return r, nil
+}
+
+type pluginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
+}
+
+func (*pluginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_plugin"
+}
+
+func (r *pluginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"display_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"server_url": schema.StringAttribute{
+				Required: true,
+			},
+			"state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[basicAuthConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"secret_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"oauth2_client_credential_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oauth2ClientCredentialConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"secret_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreatePluginInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, pluginAuthConfigurationUnionMembers)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePlugin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Q Business Plugin (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.PluginARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.PluginID = fwflex.StringToFramework(ctx, output.PluginId)
+
+	plugin, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("application_id"), data.ApplicationID)
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.PluginID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Q Business Plugin (%s)", data.PluginID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The plugin state can only be set on update.
+	if state := data.State; !state.IsUnknown() && state.ValueEnum() != plugin.State {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: aws.String(data.ApplicationID.ValueString()),
+			PluginId:      aws.String(data.PluginID.ValueString()),
+			State:         state.ValueEnum(),
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root("application_id"), data.ApplicationID)
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.PluginID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Q Business Plugin (%s) state", data.PluginID.ValueString()), err.Error())
+
+			return
+		}
+	} else {
+		// Set values for unknowns.
+		data.State = fwtypes.StringEnumValue(plugin.State)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Q Business Plugin (%s)", data.PluginID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.AuthConfiguration.Equal(old.AuthConfiguration) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.ServerURL.Equal(old.ServerURL) ||
+		!new.State.Equal(old.State) {
+		input := &qbusiness.UpdatePluginInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, pluginAuthConfigurationUnionMembers)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Q Business Plugin (%s)", new.PluginID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeletePlugin(ctx, &qbusiness.DeletePluginInput{
+		ApplicationId: aws.String(data.ApplicationID.ValueString()),
+		PluginId:      aws.String(data.PluginID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Q Business Plugin (%s)", data.PluginID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pluginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+var pluginResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "application_id", Placeholder: "APPLICATION_ID"},
+		{Name: names.AttrID, Placeholder: "PLUGIN_ID"},
+	},
+}
+
+var pluginAuthConfigurationUnionMembers = fwflex.WithUnionMembers(
+	awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration{},
+	awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration{},
+)
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type pluginResourceModel struct {
+	ApplicationID     types.String                                                  `tfsdk:"application_id"`
+	AuthConfiguration fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel] `tfsdk:"auth_configuration"`
+	DisplayName       types.String                                                  `tfsdk:"display_name"`
+	PluginARN         types.String                                                  `tfsdk:"arn"`
+	PluginID          types.String                                                  `tfsdk:"id"`
+	ServerURL         types.String                                                  `tfsdk:"server_url"`
+	State             fwtypes.StringEnum[awstypes.PluginState]                      `tfsdk:"state"`
+	Tags              types.Map                                                     `tfsdk:"tags"`
+	TagsAll           types.Map                                                     `tfsdk:"tags_all"`
+	Type              fwtypes.StringEnum[awstypes.PluginType]                       `tfsdk:"type"`
+}
+
+// pluginAuthConfigurationModel is the union of the plugin authentication configurations.
+type pluginAuthConfigurationModel struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[basicAuthConfigurationModel]              `tfsdk:"basic_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[oauth2ClientCredentialConfigurationModel] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+type basicAuthConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type oauth2ClientCredentialConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
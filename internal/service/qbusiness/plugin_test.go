@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessPlugin_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetPluginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPluginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", "id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "qbusiness", regexache.MustCompile(`application/.+/plugin/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_configuration.0.basic_auth_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_configuration.0.basic_auth_configuration.0.role_arn", "aws_iam_role.plugin", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_configuration.0.basic_auth_configuration.0.secret_arn", "aws_secretsmanager_secret.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "server_url", "https://example.atlassian.net"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttr(resourceName, "type", "JIRA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccApplicationChildImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQBusinessPlugin_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetPluginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPluginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourcePlugin, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQBusinessPlugin_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v qbusiness.GetPluginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPluginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginConfig_state(rName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccPluginConfig_state(rName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPluginDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_plugin" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindPluginByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Q Business Plugin %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPluginExists(ctx context.Context, n string, v *qbusiness.GetPluginOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindPluginByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPluginConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  secret_string = jsonencode({
+    username = "test"
+    password = "test"
+  })
+}
+
+resource "aws_iam_role" "plugin" {
+  name = "%[1]s-plugin"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qbusiness.${data.aws_partition.current.dns_suffix}"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "plugin" {
+  name = "%[1]s-plugin"
+  role = aws_iam_role.plugin.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["secretsmanager:GetSecretValue"]
+      Effect   = "Allow"
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccPluginConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPluginConfig_base(rName), fmt.Sprintf(`
+resource "aws_qbusiness_plugin" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+  server_url     = "https://example.atlassian.net"
+  type           = "JIRA"
+
+  auth_configuration {
+    basic_auth_configuration {
+      role_arn   = aws_iam_role.plugin.arn
+      secret_arn = aws_secretsmanager_secret.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.plugin, aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
+
+func testAccPluginConfig_state(rName, state string) string {
+	return acctest.ConfigCompose(testAccPluginConfig_base(rName), fmt.Sprintf(`
+resource "aws_qbusiness_plugin" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+  server_url     = "https://example.atlassian.net"
+  state          = %[2]q
+  type           = "JIRA"
+
+  auth_configuration {
+    basic_auth_configuration {
+      role_arn   = aws_iam_role.plugin.arn
+      secret_arn = aws_secretsmanager_secret.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.plugin, aws_secretsmanager_secret_version.test]
+}
+`, rName, state))
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("aws_qbusiness_application", &resource.Sweeper{
+		Name: "aws_qbusiness_application",
+		F:    sweepApplications,
+	})
+}
+
+func sweepApplications(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.QBusinessClient(ctx)
+	input := &qbusiness.ListApplicationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Q Business Application sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Q Business Applications (%s): %w", region, err)
+		}
+
+		for _, v := range page.Applications {
+			// Deleting an application deletes all of its indices, retrievers, data sources and plugins.
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute("id", aws.ToString(v.ApplicationId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Q Business Applications (%s): %w", region, err)
+	}
+
+	return nil
+}
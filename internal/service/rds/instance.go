@@ -815,7 +815,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 				if err != nil {
 					return sdkdiag.AppendErrorf(diags, "creating RDS DB Instance (read replica) (%s): %s", identifier, err)
 				}
-				crossRegion = sourceARN.Region != meta.(*conns.AWSClient).Region
+				crossRegion = sourceARN.Region != meta.(*conns.AWSClient).ResourceRegion(ctx)
 			}
 			if crossRegion {
 				input.DBParameterGroupName = aws.String(v.(string))
@@ -19,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_rds_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_db_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -43,17 +43,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
 		Name: "aws_db_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    sweepGlobalClusters,
 	})
 
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -61,7 +61,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    sweepOptionGroups,
 		Dependencies: []string{
@@ -70,7 +70,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -78,12 +78,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
 		Name: "aws_db_proxy",
 		F:    sweepProxies,
 	})
 
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -91,7 +91,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -99,7 +99,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
 		Name: "aws_db_instance_automated_backups_replication",
 		F:    sweepInstanceAutomatedBackups,
 		Dependencies: []string{
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
 		Name: "aws_redshiftserverless_namespace",
 		F:    sweepNamespaces,
 		Dependencies: []string{
@@ -24,12 +24,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
 		Name: "aws_redshiftserverless_workgroup",
 		F:    sweepWorkgroups,
 	})
 
-	resource.AddTestSweepers("aws_redshiftserverless_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_snapshot", &resource.Sweeper{
 		Name: "aws_redshiftserverless_snapshot",
 		F:    sweepSnapshots,
 	})
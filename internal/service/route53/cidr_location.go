@@ -6,7 +6,6 @@ package route53
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,17 +26,20 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
 )
 
 // @FrameworkResource
 func newResourceCIDRLocation(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCIDRLocation{}
+	r.SetCompositeID(cidrLocationResourceID)
 
 	return r, nil
 }
 
 type resourceCIDRLocation struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByCompositeID
 }
 
 func (r *resourceCIDRLocation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -300,10 +301,6 @@ func (r *resourceCIDRLocation) Delete(ctx context.Context, request resource.Dele
 	}
 }
 
-func (r *resourceCIDRLocation) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
-}
-
 type resourceCIDRLocationData struct {
 	CIDRBlocks       types.Set    `tfsdk:"cidr_blocks"`
 	CIDRCollectionID types.String `tfsdk:"cidr_collection_id"`
@@ -352,21 +349,22 @@ func findCIDRLocationByTwoPartKey(ctx context.Context, conn *route53.Route53, co
 	return output, nil
 }
 
-const cidrLocationResourceIDSeparator = ","
+var cidrLocationResourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "cidr_collection_id", Placeholder: "CIDRCOLLECTIONID"},
+		{Name: "name", Placeholder: "LOCATIONNAME"},
+	},
+}
 
 func cidrLocationCreateResourceID(collectionID, locationName string) string {
-	parts := []string{collectionID, locationName}
-	id := strings.Join(parts, cidrLocationResourceIDSeparator)
-
-	return id
+	return cidrLocationResourceID.Format(collectionID, locationName)
 }
 
 func cidrLocationParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, cidrLocationResourceIDSeparator)
-
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	parts, err := cidrLocationResourceID.Parse(id)
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CIDRCOLLECTIONID%[2]sLOCATIONNAME", id, cidrLocationResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const (
@@ -775,6 +776,30 @@ func FindResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route
 
 	fqdn := ExpandRecordName(recordName, aws.StringValue(zone.HostedZone.Name))
 	recordName = FQDN(strings.ToLower(fqdn))
+	match := func(v *route53.ResourceRecordSet) bool {
+		return recordName == strings.ToLower(CleanRecordName(aws.StringValue(v.Name))) &&
+			recordType == strings.ToUpper(aws.StringValue(v.Type)) &&
+			recordSetIdentifier == aws.StringValue(v.SetIdentifier)
+	}
+
+	// With the provider read cache enabled, list all the record sets in the hosted zone once
+	// rather than making a call for each record.
+	if conns.ReadCacheEnabled(ctx) {
+		recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range recordSets {
+			if match(v) {
+				return v, fqdn, nil
+			}
+		}
+
+		return nil, "", &retry.NotFoundError{}
+	}
+
 	input := &route53.ListResourceRecordSetsInput{
 		HostedZoneId:    aws.String(zoneID),
 		StartRecordName: aws.String(recordName),
@@ -793,13 +818,7 @@ func FindResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route
 		}
 
 		for _, v := range page.ResourceRecordSets {
-			if recordName != strings.ToLower(CleanRecordName(aws.StringValue(v.Name))) {
-				continue
-			}
-			if recordType != strings.ToUpper(aws.StringValue(v.Type)) {
-				continue
-			}
-			if recordSetIdentifier != aws.StringValue(v.SetIdentifier) {
+			if !match(v) {
 				continue
 			}
 
@@ -830,6 +849,44 @@ func FindResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route
 	return output, fqdn, nil
 }
 
+// findResourceRecordSetsByZoneID returns all the record sets in the specified hosted zone.
+// During resource Read operations with the provider read cache enabled, the result is shared by all records in the hosted zone.
+func findResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Route53, zoneID string) ([]*route53.ResourceRecordSet, error) {
+	return conns.ReadThrough(ctx, names.Route53, "ResourceRecordSets/"+zoneID, func(ctx context.Context) ([]*route53.ResourceRecordSet, error) {
+		input := &route53.ListResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+		}
+		var output []*route53.ResourceRecordSet
+
+		err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ResourceRecordSets {
+				if v != nil {
+					output = append(output, v)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output, nil
+	})
+}
+
 func ChangeResourceRecordSets(ctx context.Context, conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeInfo, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
 		return conn.ChangeResourceRecordSetsWithContext(ctx, input)
@@ -145,7 +145,7 @@ func resourceZoneCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	// Private Route53 Hosted Zones can only be created with their first VPC association,
 	// however we need to associate the remaining after creation.
-	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region)
+	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).ResourceRegion(ctx))
 
 	if len(vpcs) > 0 {
 		input.VPC = vpcs[0]
@@ -248,7 +248,7 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta interfac
 func resourceZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).ResourceRegion(ctx)
 
 	if d.HasChange("comment") {
 		input := route53.UpdateHostedZoneCommentInput{
@@ -331,29 +331,33 @@ func resourceZoneDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// FindHostedZoneByID returns the hosted zone corresponding to the specified identifier.
+// During resource Read operations with the provider read cache enabled, the result is shared by all records in the hosted zone.
 func FindHostedZoneByID(ctx context.Context, conn *route53.Route53, id string) (*route53.GetHostedZoneOutput, error) {
-	input := &route53.GetHostedZoneInput{
-		Id: aws.String(id),
-	}
+	return conns.ReadThrough(ctx, names.Route53, "HostedZone/"+id, func(ctx context.Context) (*route53.GetHostedZoneOutput, error) {
+		input := &route53.GetHostedZoneInput{
+			Id: aws.String(id),
+		}
 
-	output, err := conn.GetHostedZoneWithContext(ctx, input)
+		output, err := conn.GetHostedZoneWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
 		}
-	}
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	if output == nil || output.HostedZone == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
+		if output == nil || output.HostedZone == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
 
-	return output, nil
+		return output, nil
+	})
 }
 
 func deleteAllResourceRecordsFromHostedZone(ctx context.Context, conn *route53.Route53, hostedZoneID, hostedZoneName string) error {
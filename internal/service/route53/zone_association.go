@@ -62,7 +62,7 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
 
-	vpcRegion := meta.(*conns.AWSClient).Region
+	vpcRegion := meta.(*conns.AWSClient).ResourceRegion(ctx)
 	vpcID := d.Get("vpc_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -121,7 +121,7 @@ func resourceZoneAssociationRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if vpcRegion == "" {
-		vpcRegion = meta.(*conns.AWSClient).Region
+		vpcRegion = meta.(*conns.AWSClient).ResourceRegion(ctx)
 	}
 
 	hostedZoneSummary, err := GetZoneAssociation(ctx, conn, zoneID, vpcID, vpcRegion)
@@ -170,7 +170,7 @@ func resourceZoneAssociationDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if vpcRegion == "" {
-		vpcRegion = meta.(*conns.AWSClient).Region
+		vpcRegion = meta.(*conns.AWSClient).ResourceRegion(ctx)
 	}
 
 	input := &route53.DisassociateVPCFromHostedZoneInput{
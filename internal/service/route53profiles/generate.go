@@ -1,6 +1,8 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -KVTValues -SkipTypesImp -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -TagsFunc=TagsSlice -KeyValueTagsFunc=keyValueTagsSlice -GetTagsInFunc=getTagsInSlice -SetTagsOutFunc=setTagsOutSlice -SkipAWSServiceImp -KVTValues -ServiceTagsSlice -- tags_slice_gen.go
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
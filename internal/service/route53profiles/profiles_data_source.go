@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Profiles")
+func newProfilesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &profilesDataSource{}, nil
+}
+
+type profilesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*profilesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53profiles_profiles"
+}
+
+func (d *profilesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"profiles": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[profileSummaryModel](ctx),
+				},
+			},
+		},
+	}
+}
+
+func (d *profilesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data profilesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53ProfilesClient(ctx)
+
+	input := &route53profiles.ListProfilesInput{}
+
+	var output *route53profiles.ListProfilesOutput
+	pages := route53profiles.NewListProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("listing Route 53 Profiles", err.Error())
+
+			return
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.ProfileSummaries = append(output.ProfileSummaries, page.ProfileSummaries...)
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().ResourceRegion(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type profilesDataSourceModel struct {
+	ID               types.String                                         `tfsdk:"id"`
+	ProfileSummaries fwtypes.ListNestedObjectValueOf[profileSummaryModel] `tfsdk:"profiles"`
+}
+
+type profileSummaryModel struct {
+	ARN         types.String                             `tfsdk:"arn"`
+	ID          types.String                             `tfsdk:"id"`
+	Name        types.String                             `tfsdk:"name"`
+	ShareStatus fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"share_status"`
+}
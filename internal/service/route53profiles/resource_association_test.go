@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53profiles "github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ProfilesResourceAssociation_hostedZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ProfileResourceAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_route53profiles_resource_association.test"
+	profileResourceName := "aws_route53profiles_profile.test"
+	zoneResourceName := "aws_route53_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_hostedZone(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_id", profileResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", zoneResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "PRIVATE_HOSTED_ZONE"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53ProfilesResourceAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ProfileResourceAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_route53profiles_resource_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_hostedZone(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfroute53profiles.ResourceResourceAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ProfilesResourceAssociation_resolverRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ProfileResourceAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_route53profiles_resource_association.test"
+	ruleResourceName := "aws_route53_resolver_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_resolverRule(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", ruleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "RESOLVER_RULE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53ProfilesResourceAssociation_firewallRuleGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ProfileResourceAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53profiles_resource_association.test"
+	ruleGroupResourceName := "aws_route53_resolver_firewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName, rName, 101),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", ruleGroupResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_properties", `{"priority":101}`),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "FIREWALL_RULE_GROUP"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName, rName+"-updated", 102),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "resource_properties", `{"priority":102}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ProfilesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53profiles_resource_association" {
+				continue
+			}
+
+			_, err := tfroute53profiles.FindResourceAssociationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Route 53 Profile Resource Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceAssociationExists(ctx context.Context, n string, v *awstypes.ProfileResourceAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ProfilesClient(ctx)
+
+		output, err := tfroute53profiles.FindResourceAssociationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResourceAssociationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccProfileConfig_basic(rName), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccResourceAssociationConfig_hostedZone(rName, domainName string) string {
+	return acctest.ConfigCompose(testAccResourceAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_route53profiles_resource_association" "test" {
+  name         = %[1]q
+  profile_id   = aws_route53profiles_profile.test.id
+  resource_arn = aws_route53_zone.test.arn
+}
+`, rName, domainName))
+}
+
+func testAccResourceAssociationConfig_resolverRule(rName, domainName string) string {
+	return acctest.ConfigCompose(testAccResourceAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = %[2]q
+  rule_type   = "SYSTEM"
+  name        = %[1]q
+}
+
+resource "aws_route53profiles_resource_association" "test" {
+  name         = %[1]q
+  profile_id   = aws_route53profiles_profile.test.id
+  resource_arn = aws_route53_resolver_rule.test.arn
+}
+`, rName, domainName))
+}
+
+func testAccResourceAssociationConfig_firewallRuleGroup(rName, associationName string, priority int) string {
+	return acctest.ConfigCompose(testAccResourceAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53profiles_resource_association" "test" {
+  name         = %[2]q
+  profile_id   = aws_route53profiles_profile.test.id
+  resource_arn = aws_route53_resolver_firewall_rule_group.test.arn
+  resource_properties = jsonencode({
+    priority = %[3]d
+  })
+}
+`, rName, associationName, priority))
+}
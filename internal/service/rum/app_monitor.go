@@ -205,7 +205,7 @@ func resourceAppMonitorRead(ctx context.Context, d *schema.ResourceData, meta in
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("appmonitor/%s", aws.StringValue(appMon.Name)),
 		Service:   "rum",
 	}.String()
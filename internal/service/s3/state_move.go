@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// StateMoves returns the moves of resource state, via Terraform `moved` blocks, supported by this service package.
+// The `aws_s3_bucket_object` resource type is the deprecated predecessor of the `aws_s3_object` resource type.
+//
+// Moving an `aws_s3_bucket` resource's inline sub-configuration to the split `aws_s3_bucket_*` resource
+// removes the bucket from state. Terraform doesn't allow a `moved` block's `from` address to remain in configuration,
+// so the bucket, without the sub-configuration, is imported again at a new address:
+//
+//	resource "aws_s3_bucket" "example_bucket" {
+//	  bucket = "example-bucket"
+//	}
+//
+//	resource "aws_s3_bucket_versioning" "example" {
+//	  bucket = aws_s3_bucket.example_bucket.id
+//	  ...
+//	}
+//
+//	moved {
+//	  from = aws_s3_bucket.example
+//	  to   = aws_s3_bucket_versioning.example
+//	}
+//
+//	import {
+//	  to = aws_s3_bucket.example_bucket
+//	  id = "example-bucket"
+//	}
+func (p *servicePackage) StateMoves(context.Context) []*framework.StateMove {
+	bucketSubConfiguration := func(targetTypeName string, attributes map[string]string, required ...string) *framework.StateMove {
+		attributes["id"] = "bucket"
+		attributes["bucket"] = "bucket"
+
+		return &framework.StateMove{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: targetTypeName,
+			Attributes:     attributes,
+			Required:       required,
+		}
+	}
+
+	versioning := bucketSubConfiguration("aws_s3_bucket_versioning", map[string]string{}, "versioning.0")
+	versioning.Transform = func(_ context.Context, source, target map[string]any) error {
+		status, mfaDelete := types.BucketVersioningStatusSuspended, types.MFADeleteDisabled
+		if v, ok := framework.AttributeAtPath(source, "versioning.0.enabled"); ok && v == true {
+			status = types.BucketVersioningStatusEnabled
+		}
+		if v, ok := framework.AttributeAtPath(source, "versioning.0.mfa_delete"); ok && v == true {
+			mfaDelete = types.MFADeleteEnabled
+		}
+
+		target["versioning_configuration"] = []any{
+			map[string]any{
+				"mfa_delete": string(mfaDelete),
+				"status":     string(status),
+			},
+		}
+
+		return nil
+	}
+
+	return []*framework.StateMove{
+		{
+			SourceTypeName: "aws_s3_bucket_object",
+			TargetTypeName: "aws_s3_object",
+			CopyAttributes: true,
+		},
+		bucketSubConfiguration("aws_s3_bucket_accelerate_configuration", map[string]string{
+			"status": "acceleration_status",
+		}, "acceleration_status"),
+		bucketSubConfiguration("aws_s3_bucket_cors_configuration", map[string]string{
+			"cors_rule": "cors_rule",
+		}, "cors_rule"),
+		bucketSubConfiguration("aws_s3_bucket_logging", map[string]string{
+			"target_bucket": "logging.0.target_bucket",
+			"target_prefix": "logging.0.target_prefix",
+		}, "logging.0.target_bucket"),
+		bucketSubConfiguration("aws_s3_bucket_policy", map[string]string{
+			"policy": "policy",
+		}, "policy"),
+		bucketSubConfiguration("aws_s3_bucket_request_payment_configuration", map[string]string{
+			"payer": "request_payer",
+		}, "request_payer"),
+		bucketSubConfiguration("aws_s3_bucket_server_side_encryption_configuration", map[string]string{
+			"rule": "server_side_encryption_configuration.0.rule",
+		}, "server_side_encryption_configuration.0.rule"),
+		versioning,
+	}
+}
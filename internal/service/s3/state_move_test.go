@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestStateMoveBucketToBucketVersioning(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	versioningConfigurationType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"mfa_delete": tftypes.String,
+			"status":     tftypes.String,
+		},
+	}
+	targetType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                       tftypes.String,
+			"bucket":                   tftypes.String,
+			"versioning_configuration": tftypes.List{ElementType: versioningConfigurationType},
+		},
+	}
+
+	var move *framework.StateMove
+	for _, v := range tfs3.ServicePackage(ctx).(interface {
+		StateMoves(context.Context) []*framework.StateMove
+	}).StateMoves(ctx) {
+		if v.SourceTypeName == "aws_s3_bucket" && v.TargetTypeName == "aws_s3_bucket_versioning" {
+			move = v
+		}
+	}
+	if move == nil {
+		t.Fatal("aws_s3_bucket to aws_s3_bucket_versioning move not found")
+	}
+
+	expected := func(status, mfaDelete string) tftypes.Value {
+		return tftypes.NewValue(targetType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, "example"),
+			"bucket": tftypes.NewValue(tftypes.String, "example"),
+			"versioning_configuration": tftypes.NewValue(tftypes.List{ElementType: versioningConfigurationType}, []tftypes.Value{
+				tftypes.NewValue(versioningConfigurationType, map[string]tftypes.Value{
+					"mfa_delete": tftypes.NewValue(tftypes.String, mfaDelete),
+					"status":     tftypes.NewValue(tftypes.String, status),
+				}),
+			}),
+		})
+	}
+
+	testCases := []struct {
+		name        string
+		sourceJSON  string
+		expected    tftypes.Value
+		expectError bool
+	}{
+		{
+			name:       "enabled",
+			sourceJSON: `{"id":"example","bucket":"example","versioning":[{"enabled":true,"mfa_delete":false}]}`,
+			expected:   expected("Enabled", "Disabled"),
+		},
+		{
+			name:       "suspended with MFA delete",
+			sourceJSON: `{"id":"example","bucket":"example","versioning":[{"enabled":false,"mfa_delete":true}]}`,
+			expected:   expected("Suspended", "Enabled"),
+		},
+		{
+			name:        "no versioning",
+			sourceJSON:  `{"id":"example","bucket":"example","versioning":[]}`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := move.Move(ctx, 0, []byte(testCase.sourceJSON), targetType)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err == nil && !got.Equal(testCase.expected) {
+				t.Errorf("target state = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -25,12 +25,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -41,7 +41,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
 		Name: "aws_s3_directory_bucket",
 		F:    sweepDirectoryBuckets,
 		Dependencies: []string{
@@ -271,7 +271,7 @@ func resourceAccessPointRead(ctx context.Context, d *schema.ResourceData, meta i
 		accessPointARN := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   "s3",
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 			AccountID: accountID,
 			Resource:  fmt.Sprintf("accesspoint/%s", aws.ToString(output.Name)),
 		}
@@ -18,27 +18,27 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
 		Name: "aws_sagemaker_app_image_config",
 		F:    sweepAppImagesConfig,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
 		Name: "aws_sagemaker_app",
 		F:    sweepApps,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    sweepCodeRepositories,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
 		Name: "aws_sagemaker_device_fleet",
 		F:    sweepDeviceFleets,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
 		Name: "aws_sagemaker_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -49,7 +49,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint_configuration",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -57,7 +57,7 @@ func RegisterSweepers() {
 		F: sweepEndpointConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -66,37 +66,37 @@ func RegisterSweepers() {
 		F: sweepEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    sweepFeatureGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
 		Name: "aws_sagemaker_flow_definition",
 		F:    sweepFlowDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
 		Name: "aws_sagemaker_human_task_ui",
 		F:    sweepHumanTaskUIs,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
 		Name: "aws_sagemaker_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    sweepModels,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    sweepNotebookInstanceLifecycleConfiguration,
 		Dependencies: []string{
@@ -104,12 +104,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance",
 		F:    sweepNotebookInstances,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
 		Name: "aws_sagemaker_studio_lifecycle_config",
 		F:    sweepStudioLifecyclesConfig,
 		Dependencies: []string{
@@ -117,12 +117,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
 		Name: "aws_sagemaker_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_space", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_space", &resource.Sweeper{
 		Name: "aws_sagemaker_space",
 		F:    sweepSpaces,
 		Dependencies: []string{
@@ -130,7 +130,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
 		Name: "aws_sagemaker_user_profile",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -138,7 +138,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
 		Name: "aws_sagemaker_workforce",
 		F:    sweepWorkforces,
 		Dependencies: []string{
@@ -146,12 +146,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
 		Name: "aws_sagemaker_workteam",
 		F:    sweepWorkteams,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_pipeline", &resource.Sweeper{
 		Name: "aws_sagemaker_pipeline",
 		F:    sweepPipelines,
 	})
@@ -108,7 +108,7 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "updating Security Hub Account (%s): %s", d.Id(), err)
 	}
 
-	arn := accountHubARN(meta.(*conns.AWSClient))
+	arn := accountHubARN(ctx, meta.(*conns.AWSClient))
 	const (
 		timeout = 1 * time.Minute
 	)
@@ -133,7 +133,7 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
 
-	arn := accountHubARN(meta.(*conns.AWSClient))
+	arn := accountHubARN(ctx, meta.(*conns.AWSClient))
 	output, err := findHubByARN(ctx, conn, arn)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -226,6 +226,6 @@ func findHub(ctx context.Context, conn *securityhub.Client, input *securityhub.D
 }
 
 // Security Hub ARN: https://docs.aws.amazon.com/service-authorization/latest/reference/list_awssecurityhub.html#awssecurityhub-resources-for-iam-policies
-func accountHubARN(meta *conns.AWSClient) string {
-	return fmt.Sprintf("arn:%s:securityhub:%s:%s:hub/default", meta.Partition, meta.Region, meta.AccountID)
+func accountHubARN(ctx context.Context, meta *conns.AWSClient) string {
+	return fmt.Sprintf("arn:%s:securityhub:%s:%s:hub/default", meta.Partition, meta.ResourceRegion(ctx), meta.AccountID)
 }
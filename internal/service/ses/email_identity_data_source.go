@@ -66,7 +66,7 @@ func dataSourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, me
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		Resource:  fmt.Sprintf("identity/%s", email),
 		Service:   "ses",
 	}.String()
@@ -244,7 +244,7 @@ func resourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData, m
 		return create.DiagError(names.SESV2, create.ErrActionReading, ResNameConfigurationSet, d.Id(), err)
 	}
 
-	d.Set("arn", configurationSetNameToARN(meta, aws.ToString(out.ConfigurationSetName)))
+	d.Set("arn", configurationSetNameToARN(ctx, meta, aws.ToString(out.ConfigurationSetName)))
 	d.Set("configuration_set_name", out.ConfigurationSetName)
 
 	if out.DeliveryOptions != nil {
@@ -725,11 +725,11 @@ func expandGuardianOptions(tfMap map[string]interface{}) *types.GuardianOptions
 	return a
 }
 
-func configurationSetNameToARN(meta interface{}, configurationSetName string) string {
+func configurationSetNameToARN(ctx context.Context, meta interface{}, configurationSetName string) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("configuration-set/%s", configurationSetName),
 	}.String()
@@ -112,7 +112,7 @@ func resourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData, me
 	poolName := aws.ToString(out.DedicatedIpPool.PoolName)
 	d.Set("pool_name", poolName)
 	d.Set("scaling_mode", string(out.DedicatedIpPool.ScalingMode))
-	d.Set("arn", poolNameToARN(meta, poolName))
+	d.Set("arn", poolNameToARN(ctx, meta, poolName))
 
 	return nil
 }
@@ -165,11 +165,11 @@ func FindDedicatedIPPoolByID(ctx context.Context, conn *sesv2.Client, id string)
 	return out, nil
 }
 
-func poolNameToARN(meta interface{}, poolName string) string {
+func poolNameToARN(ctx context.Context, meta interface{}, poolName string) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("dedicated-ip-pool/%s", poolName),
 	}.String()
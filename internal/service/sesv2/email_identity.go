@@ -159,11 +159,11 @@ func resourceEmailIdentityCreate(ctx context.Context, d *schema.ResourceData, me
 	return resourceEmailIdentityRead(ctx, d, meta)
 }
 
-func emailIdentityNameToARN(meta interface{}, emailIdentityName string) string {
+func emailIdentityNameToARN(ctx context.Context, meta interface{}, emailIdentityName string) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("identity/%s", emailIdentityName),
 	}.String()
@@ -184,7 +184,7 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.SESV2, create.ErrActionReading, ResNameEmailIdentity, d.Id(), err)
 	}
 
-	arn := emailIdentityNameToARN(meta, d.Id())
+	arn := emailIdentityNameToARN(ctx, meta, d.Id())
 
 	d.Set("arn", arn)
 	d.Set("configuration_set_name", out.ConfigurationSetName)
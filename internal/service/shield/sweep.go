@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &resource.Sweeper{
 		Name: "aws_shield_drt_access_log_bucket_association",
 		F:    sweepDRTAccessLogBucketAssociations,
 	})
 
-	resource.AddTestSweepers("aws_shield_drt_access_role_arn_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_shield_drt_access_role_arn_association", &resource.Sweeper{
 		Name: "aws_shield_drt_access_role_arn_association",
 		F:    sweepDRTAccessRoleARNAssociations,
 		Dependencies: []string{
@@ -29,7 +29,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_shield_proactive_engagement", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_shield_proactive_engagement", &resource.Sweeper{
 		Name: "aws_shield_proactive_engagement",
 		F:    sweepProactiveEngagements,
 		Dependencies: []string{
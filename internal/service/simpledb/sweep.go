@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_simpledb_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_simpledb_domain", &resource.Sweeper{
 		Name: "aws_simpledb_domain",
 		F:    sweepDomains,
 	})
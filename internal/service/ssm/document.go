@@ -331,7 +331,7 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ssm",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("document/%s", aws.StringValue(doc.Name)),
 	}.String()
@@ -85,7 +85,7 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		instanceIDs = append(instanceIDs, aws.StringValue(r.InstanceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("ids", instanceIDs)
 
 	return diags
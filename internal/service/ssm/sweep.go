@@ -26,17 +26,17 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepPatchBaselines,
 		Dependencies: []string{
@@ -45,12 +45,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_group", &resource.Sweeper{
 		Name: "aws_ssm_patch_group",
 		F:    sweepPatchGroups,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssmcontacts_rotation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssmcontacts_rotation", &resource.Sweeper{
 		Name: "aws_ssmcontacts_rotation",
 		F:    sweepRotations,
 	})
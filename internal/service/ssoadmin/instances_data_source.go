@@ -52,7 +52,7 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		arns = append(arns, aws.ToString(v.InstanceArn))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).ResourceRegion(ctx))
 	d.Set("arns", arns)
 	d.Set("identity_store_ids", identityStoreIDs)
 
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
 		Name: "aws_verifiedpermissions_policy_store",
 		F:    sweepPolicyStores,
 	})
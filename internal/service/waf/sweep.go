@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
 		Name: "aws_waf_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
 		Name: "aws_waf_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
 		Name: "aws_waf_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -48,7 +48,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
 		Name: "aws_waf_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -57,7 +57,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
 		Name: "aws_waf_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -67,7 +67,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_waf_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -77,7 +77,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
 		Name: "aws_waf_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -85,7 +85,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
 		Name: "aws_waf_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -94,7 +94,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
 		Name: "aws_waf_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -104,7 +104,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
 		Name: "aws_waf_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -114,12 +114,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
 		Name: "aws_waf_web_acl",
 		F:    sweepWebACLs,
 	})
 
-	resource.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
 		Name: "aws_waf_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -26,22 +26,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSets,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -49,7 +49,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
 	})
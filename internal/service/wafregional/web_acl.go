@@ -182,7 +182,7 @@ func resourceWebACL() *schema.Resource {
 func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).ResourceRegion(ctx)
 
 	wr := NewRetryer(conn, region)
 	out, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
@@ -208,7 +208,7 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		webACLARN = arn.ARN{
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
 			Service:   "waf-regional",
 		}.String()
@@ -278,7 +278,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 		webACLARN = arn.ARN{
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Partition: meta.(*conns.AWSClient).Partition,
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).ResourceRegion(ctx),
 			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
 			Service:   "waf-regional",
 		}.String()
@@ -320,7 +320,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).ResourceRegion(ctx)
 
 	if d.HasChanges("default_action", "rule") {
 		o, n := d.GetChange("rule")
@@ -371,7 +371,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).ResourceRegion(ctx)
 	wr := NewRetryer(conn, region)
 
 	if rules := d.Get("rule").(*schema.Set).List(); len(rules) > 0 {
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
 		Name: "aws_workspaces_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -25,12 +25,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
 
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"encoding/json"
 	"math/rand"
 	"strings"
 	"time"
@@ -118,6 +119,21 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// MarshalJSON returns the attributes set by the sweeper.
+func (sr *sweepResource) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Attributes map[string]any `json:"attributes"`
+	}{
+		Attributes: make(map[string]any, len(sr.attributes)),
+	}
+
+	for _, attr := range sr.attributes {
+		v.Attributes[attr.path] = attr.value
+	}
+
+	return json.Marshal(v)
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+const DefaultParallelism = 4
+
+// sweeper is a registered sweeper.
+type sweeper struct {
+	*resource.Sweeper
+	// list returns the resources that the sweeper would delete.
+	// nil if the sweeper does not support dry runs.
+	list func(context.Context, string) ([]Sweepable, error)
+}
+
+// sweepers contains all registered sweepers, keyed by name.
+var sweepers = make(map[string]sweeper)
+
+func addSweeper(name string, s *resource.Sweeper, list func(context.Context, string) ([]Sweepable, error)) {
+	sweepers[name] = sweeper{
+		Sweeper: s,
+		list:    list,
+	}
+}
+
+// RunOptions contains options for running sweepers.
+type RunOptions struct {
+	// AllowFailures continues sweeping after a sweeper fails.
+	// Sweepers that depend on a failed sweeper are never run.
+	AllowFailures bool
+	// DryRun lists the resources that would be swept instead of deleting them.
+	DryRun bool
+	// Filter is a comma-separated list of sweeper names to run.
+	// A sweeper is run if its name contains any of the filter values.
+	// The dependencies of matching sweepers are always run.
+	Filter string
+	// Output is where the dry run results are written.
+	Output io.Writer
+	// Parallelism is the maximum number of sweepers run concurrently.
+	Parallelism int
+}
+
+// Run runs the registered sweepers in each of the specified Regions.
+// A sweeper is only run once all the sweepers it depends on have run successfully.
+// Sweepers with no outstanding dependencies are run concurrently.
+func Run(regions []string, opts RunOptions) error {
+	if opts.Parallelism < 1 {
+		opts.Parallelism = DefaultParallelism
+	}
+
+	g, err := sweeperGraph(sweepers, opts.Filter)
+	if err != nil {
+		return err
+	}
+
+	levels, err := sweeperLevels(g)
+	if err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		return dryRun(sweepers, regions, slices.Concat(levels...), opts)
+	}
+
+	var errs []error
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		if err := runRegion(region, g, levels, opts); err != nil {
+			errs = append(errs, fmt.Errorf("sweeping Region (%s): %w", region, err))
+
+			if !opts.AllowFailures {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweeperGraph returns the dependency graph of the sweepers matching the specified filter and all their dependencies.
+func sweeperGraph(sweepers map[string]sweeper, filter string) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	var add func(string) error
+	add = func(name string) error {
+		if g.HasNode(name) {
+			return nil
+		}
+
+		s, ok := sweepers[name]
+		if !ok {
+			return fmt.Errorf("sweeper (%s) not found", name)
+		}
+
+		g.AddNode(name)
+
+		for _, dependency := range s.Dependencies {
+			if err := add(dependency); err != nil {
+				return fmt.Errorf("sweeper (%s) dependency: %w", name, err)
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var filters []string
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			filters = append(filters, v)
+		}
+	}
+
+	names := tfmaps.Keys(sweepers)
+	slices.Sort(names)
+
+	for _, name := range names {
+		if len(filters) > 0 && !slices.ContainsFunc(filters, func(v string) bool {
+			return strings.Contains(strings.ToLower(name), v)
+		}) {
+			continue
+		}
+
+		if err := add(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// sweeperLevels partitions the sweepers in the specified dependency graph into levels.
+// Each sweeper's dependencies are all in earlier levels.
+func sweeperLevels(g *depgraph.Graph) ([][]string, error) {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	var levels [][]string
+	levelOf := make(map[string]int, len(order))
+
+	// In the overall order every sweeper follows all its dependencies.
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		level := 0
+		for _, dependency := range dependencies {
+			level = max(level, levelOf[dependency]+1)
+		}
+		levelOf[name] = level
+
+		if level == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[level] = append(levels[level], name)
+	}
+
+	for _, level := range levels {
+		slices.Sort(level)
+	}
+
+	return levels, nil
+}
+
+// runRegion runs sweepers level by level in the specified Region.
+func runRegion(region string, g *depgraph.Graph, levels [][]string, opts RunOptions) error {
+	ctx := Context(region)
+
+	var lock sync.Mutex
+	unswept := make(map[string]struct{}) // Sweepers that failed or were skipped.
+	var errs []error
+
+	tflog.Info(ctx, "Running sweepers")
+	start := time.Now()
+
+	for _, level := range levels {
+		var group multierror.Group
+		semaphore := tfsync.NewSemaphore(opts.Parallelism)
+
+		for _, name := range level {
+			name := name
+			ctx := logWithResourceType(ctx, name)
+
+			dependencies, err := g.DirectDependenciesOf(name)
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			skip := slices.ContainsFunc(dependencies, func(v string) bool {
+				_, ok := unswept[v]
+				return ok
+			})
+			if skip {
+				unswept[name] = struct{}{}
+			}
+			lock.Unlock()
+
+			if skip {
+				tflog.Warn(ctx, "Skipping sweeper as a dependency was not swept")
+				continue
+			}
+
+			f := sweepers[name].F
+
+			group.Go(func() error {
+				semaphore.Wait()
+				defer semaphore.Notify()
+
+				tflog.Debug(ctx, "Running sweeper")
+				start := time.Now()
+
+				err := f(region)
+
+				tflog.Debug(ctx, "Completed sweeper", map[string]any{
+					"elapsed": time.Since(start).String(),
+				})
+
+				if err != nil {
+					tflog.Error(ctx, "Sweeper failed", map[string]any{
+						"error": err.Error(),
+					})
+
+					lock.Lock()
+					unswept[name] = struct{}{}
+					lock.Unlock()
+
+					return fmt.Errorf("sweeper (%s): %w", name, err)
+				}
+
+				return nil
+			})
+		}
+
+		if err := group.Wait().ErrorOrNil(); err != nil {
+			errs = append(errs, err)
+
+			if !opts.AllowFailures {
+				break
+			}
+		}
+	}
+
+	tflog.Info(ctx, "Completed sweepers", map[string]any{
+		"elapsed": time.Since(start).String(),
+	})
+
+	return errors.Join(errs...)
+}
+
+type dryRunRegion struct {
+	Region   string          `json:"region"`
+	Sweepers []dryRunSweeper `json:"sweepers"`
+}
+
+type dryRunSweeper struct {
+	Name string `json:"name"`
+	// DryRunUnsupported is true if the sweeper cannot list resources without deleting them,
+	// i.e. it was registered with AddTestSweepers rather than Register.
+	DryRunUnsupported bool        `json:"dry_run_unsupported,omitempty"`
+	Error             string      `json:"error,omitempty"`
+	Resources         []Sweepable `json:"resources,omitempty"`
+}
+
+// dryRun writes the resources that each of the specified sweepers would delete in each Region as JSON.
+// Only sweepers that list resources separately from deleting them are run.
+// Other sweepers' functions delete resources, so they are never called during a dry run.
+func dryRun(sweepers map[string]sweeper, regions []string, names []string, opts RunOptions) error {
+	results := make([]dryRunRegion, 0, len(regions))
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		ctx := Context(region)
+		result := dryRunRegion{
+			Region:   region,
+			Sweepers: make([]dryRunSweeper, 0, len(names)),
+		}
+
+		for _, name := range names {
+			ctx := logWithResourceType(ctx, name)
+			v := dryRunSweeper{
+				Name: name,
+			}
+
+			if list := sweepers[name].list; list == nil {
+				v.DryRunUnsupported = true
+			} else if sweepables, err := list(ctx, region); err != nil {
+				v.Error = err.Error()
+			} else {
+				v.Resources = sweepables
+			}
+
+			result.Sweepers = append(result.Sweepers, v)
+		}
+
+		results = append(results, result)
+	}
+
+	encoder := json.NewEncoder(opts.Output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(results)
+}
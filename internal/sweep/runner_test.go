@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSweeperLevels(t *testing.T) {
+	t.Parallel()
+
+	testSweepers := func(dependencies map[string][]string) map[string]sweeper {
+		sweepers := make(map[string]sweeper, len(dependencies))
+		for name, dependencies := range dependencies {
+			sweepers[name] = sweeper{
+				Sweeper: &resource.Sweeper{
+					Name:         name,
+					Dependencies: dependencies,
+				},
+			}
+		}
+		return sweepers
+	}
+
+	testCases := []struct {
+		name     string
+		sweepers map[string]sweeper
+		filter   string
+		want     [][]string
+		wantErr  bool
+	}{
+		{
+			name:     "no sweepers",
+			sweepers: testSweepers(map[string][]string{}),
+		},
+		{
+			name: "no dependencies",
+			sweepers: testSweepers(map[string][]string{
+				"aws_b": nil,
+				"aws_a": nil,
+			}),
+			want: [][]string{
+				{"aws_a", "aws_b"},
+			},
+		},
+		{
+			name: "vpc",
+			sweepers: testSweepers(map[string][]string{
+				"aws_instance":         nil,
+				"aws_internet_gateway": nil,
+				"aws_subnet":           {"aws_instance"},
+				"aws_vpc":              {"aws_internet_gateway", "aws_subnet"},
+			}),
+			want: [][]string{
+				{"aws_instance", "aws_internet_gateway"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			name: "filter includes dependencies",
+			sweepers: testSweepers(map[string][]string{
+				"aws_iam_role":  nil,
+				"aws_instance":  nil,
+				"aws_subnet":    {"aws_instance"},
+				"aws_vpc":       {"aws_subnet"},
+				"aws_s3_bucket": nil,
+			}),
+			filter: "VPC,aws_iam",
+			want: [][]string{
+				{"aws_iam_role", "aws_instance"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			name: "missing dependency",
+			sweepers: testSweepers(map[string][]string{
+				"aws_vpc": {"aws_subnet"},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "dependency cycle",
+			sweepers: testSweepers(map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_a"},
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := sweeperGraph(testCase.sweepers, testCase.filter)
+			var got [][]string
+			if err == nil {
+				got, err = sweeperLevels(g)
+			}
+
+			if gotErr := err != nil; gotErr != testCase.wantErr {
+				t.Fatalf("err = %v, want error %t", err, testCase.wantErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type testSweepable struct {
+	ID string `json:"id"`
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return errors.New("resource deleted during dry run")
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]sweeper{
+		"aws_listed": {
+			Sweeper: &resource.Sweeper{
+				Name: "aws_listed",
+				F: func(string) error {
+					t.Error("sweeper function called during dry run")
+					return nil
+				},
+			},
+			list: func(_ context.Context, region string) ([]Sweepable, error) {
+				return []Sweepable{testSweepable{ID: region + "-1"}, testSweepable{ID: region + "-2"}}, nil
+			},
+		},
+		"aws_list_error": {
+			Sweeper: &resource.Sweeper{
+				Name: "aws_list_error",
+			},
+			list: func(context.Context, string) ([]Sweepable, error) {
+				return nil, errors.New("listing")
+			},
+		},
+		"aws_unlisted": {
+			Sweeper: &resource.Sweeper{
+				Name: "aws_unlisted",
+				F: func(string) error {
+					t.Error("sweeper function called during dry run")
+					return nil
+				},
+			},
+		},
+	}
+
+	var output bytes.Buffer
+	err := dryRun(sweepers, []string{"region-1"}, []string{"aws_list_error", "aws_listed", "aws_unlisted"}, RunOptions{
+		Output: &output,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []map[string]any
+	if err := json.Unmarshal(output.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshaling output: %s", err)
+	}
+
+	want := []map[string]any{
+		{
+			"region": "region-1",
+			"sweepers": []any{
+				map[string]any{
+					"name":  "aws_list_error",
+					"error": "listing",
+				},
+				map[string]any{
+					"name": "aws_listed",
+					"resources": []any{
+						map[string]any{"id": "region-1-1"},
+						map[string]any{"id": "region-1-2"},
+					},
+				},
+				map[string]any{
+					"name":                "aws_unlisted",
+					"dry_run_unsupported": true,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	"math/rand"
 	"strings"
 	"time"
@@ -89,6 +90,27 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// MarshalJSON returns the resource's ID and any attributes set by the sweeper.
+func (sr *sweepResource) MarshalJSON() ([]byte, error) {
+	v := struct {
+		ID         string            `json:"id"`
+		Attributes map[string]string `json:"attributes,omitempty"`
+	}{
+		ID: sr.d.Id(),
+	}
+
+	if state := sr.d.State(); state != nil {
+		v.Attributes = make(map[string]string, len(state.Attributes))
+		for k, s := range state.Attributes {
+			if k != "id" {
+				v.Attributes[k] = s
+			}
+		}
+	}
+
+	return json.Marshal(v)
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
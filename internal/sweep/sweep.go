@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,10 +36,16 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -69,24 +77,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -138,27 +150,39 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// Register registers a sweeper that lists the resources to be swept.
+// Sweepers registered this way support dry runs.
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+	list := func(ctx context.Context, region string) ([]Sweepable, error) {
+		client, err := SharedRegionalSweepClient(ctx, region)
+		if err != nil {
+			return nil, fmt.Errorf("getting client: %w", err)
+		}
+		sweepResources, err := f(ctx, client)
+
+		if SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing %q (%s): %w", name, region, err)
+		}
+
+		return sweepResources, nil
+	}
+
+	s := &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
 
-			client, err := SharedRegionalSweepClient(ctx, region)
-			if err != nil {
-				return fmt.Errorf("getting client: %w", err)
-			}
-			sweepResources, err := f(ctx, client)
-
-			if SkipSweepError(err) {
-				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
-					"error": err.Error(),
-				})
-				return nil
-			}
+			sweepResources, err := list(ctx, region)
 			if err != nil {
-				return fmt.Errorf("listing %q (%s): %w", name, region, err)
+				return err
 			}
 
 			err = SweepOrchestrator(ctx, sweepResources)
@@ -168,5 +192,16 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 
 			return nil
 		},
-	})
+	}
+
+	addSweeper(name, s, list)
+	resource.AddTestSweepers(name, s)
+}
+
+// AddTestSweepers registers a sweeper that both lists and deletes resources.
+// Sweepers registered this way do not support dry runs: the sweeper function is never called during a dry run.
+// Use Register for new sweepers.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	addSweeper(name, s, nil)
+	resource.AddTestSweepers(name, s)
 }
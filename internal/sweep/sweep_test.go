@@ -5,12 +5,21 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources that sweepers would delete, as JSON, without deleting them")
+	flagSweepParallelism = flag.Int("sweep-parallelism", sweep.DefaultParallelism, "Maximum number of sweepers run concurrently in each Region")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +27,25 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+
+	// Replace the Terraform Plugin Testing sweeper runner with one that respects sweeper dependencies.
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		err := sweep.Run(strings.Split(regions, ","), sweep.RunOptions{
+			AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+			DryRun:        *flagSweepDryRun,
+			Filter:        flag.Lookup("sweep-run").Value.String(),
+			Output:        os.Stdout,
+			Parallelism:   *flagSweepParallelism,
+		})
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
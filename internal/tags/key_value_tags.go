@@ -39,6 +39,8 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyPatterns []*Pattern
+	TagPatterns []TagPattern
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -107,6 +109,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyPatterns(config.KeyPatterns)
+	result = result.IgnoreTagPatterns(config.TagPatterns)
 
 	return result
 }
@@ -158,6 +162,54 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyPatterns returns tags whose keys do not match any of the patterns.
+func (tags KeyValueTags) IgnoreKeyPatterns(patterns []*Pattern) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, pattern := range patterns {
+			if pattern.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreTagPatterns returns tags that do not match any of the key and value patterns.
+func (tags KeyValueTags) IgnoreTagPatterns(patterns []TagPattern) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, pattern := range patterns {
+			if pattern.Match(k, v) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
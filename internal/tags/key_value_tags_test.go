@@ -568,6 +568,101 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key patterns glob",
+			tags: New(ctx, map[string]string{
+				"scanner:2024-01:finding": "value1",
+				"team/alpha/owner":        "value2",
+				"team/alpha/lead":         "value3",
+				"key4":                    "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*Pattern{
+					testPattern(t, "scanner:2024-??:*"),
+					testPattern(t, "team/*/owner"),
+				},
+			},
+			want: map[string]string{
+				"team/alpha/lead": "value3",
+				"key4":            "value4",
+			},
+		},
+		{
+			name: "key patterns regular expression",
+			tags: New(ctx, map[string]string{
+				"team/alpha/owner": "value1",
+				"team/beta/owner":  "value2",
+				"team/x/y/owner":   "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*Pattern{
+					testPattern(t, `/^team/[^/]+/owner$/`),
+				},
+			},
+			want: map[string]string{
+				"team/x/y/owner": "value3",
+			},
+		},
+		{
+			name: "tag patterns key and value",
+			tags: New(ctx, map[string]string{
+				"key1": "managed-by-scanner",
+				"key2": "value2",
+				"key3": "managed-by-scanner",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				TagPatterns: []TagPattern{
+					{
+						Key:   testPattern(t, "key?"),
+						Value: testPattern(t, "*-by-scanner"),
+					},
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "tag patterns value only",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				TagPatterns: []TagPattern{
+					{
+						Value: testPattern(t, `/^value[12]$/`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys key prefixes and key patterns",
+			tags: New(ctx, map[string]string{
+				"key1":     "value1",
+				"prefix:2": "value2",
+				"glob-3":   "value3",
+				"key4":     "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				KeyPrefixes: New(ctx, []string{
+					"prefix:",
+				}),
+				KeyPatterns: []*Pattern{
+					testPattern(t, "glob-*"),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Pattern matches resource tag keys or values.
+// A pattern enclosed in forward slashes, e.g. `/^team\/[^\/]+\/owner$/`, is an RE2 regular expression.
+// Any other pattern is a glob which must match the whole string, in which `*` matches any sequence
+// of characters (including none) and `?` matches any single character.
+type Pattern struct {
+	expr string
+	re   *regexp.Regexp
+}
+
+// NewPattern compiles the specified pattern expression.
+func NewPattern(expr string) (*Pattern, error) {
+	var re *regexp.Regexp
+	var err error
+
+	if n := len(expr); n >= 2 && strings.HasPrefix(expr, "/") && strings.HasSuffix(expr, "/") {
+		re, err = regexp.Compile(expr[1 : n-1])
+	} else {
+		re, err = regexp.Compile(globToRegexp(expr))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag pattern (%s): %w", expr, err)
+	}
+
+	return &Pattern{
+		expr: expr,
+		re:   re,
+	}, nil
+}
+
+// MatchString returns whether the specified string matches the pattern.
+// A nil pattern matches any string.
+func (p *Pattern) MatchString(s string) bool {
+	if p == nil {
+		return true
+	}
+
+	return p.re.MatchString(s)
+}
+
+// String returns the pattern's source expression.
+func (p *Pattern) String() string {
+	if p == nil {
+		return ""
+	}
+
+	return p.expr
+}
+
+// TagPattern matches resource tags by key and value.
+// A nil Key or Value pattern matches any key or value respectively.
+type TagPattern struct {
+	Key   *Pattern
+	Value *Pattern
+}
+
+// Match returns whether the specified tag matches both the key and value patterns.
+func (p TagPattern) Match(key string, value *TagData) bool {
+	if !p.Key.MatchString(key) {
+		return false
+	}
+
+	if p.Value == nil {
+		return true
+	}
+
+	var s string
+	if value != nil {
+		s = value.ValueString()
+	}
+
+	return p.Value.MatchString(s)
+}
+
+// globToRegexp converts a glob to an anchored regular expression.
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+
+	sb.WriteString(`(?s)^`)
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteString(`.*`)
+		case '?':
+			sb.WriteString(`.`)
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString(`$`)
+
+	return sb.String()
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"testing"
+)
+
+func TestPatternMatchString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		expr    string
+		s       string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "glob exact",
+			expr: "key1",
+			s:    "key1",
+			want: true,
+		},
+		{
+			name: "glob exact is anchored",
+			expr: "key",
+			s:    "key1",
+			want: false,
+		},
+		{
+			name: "glob star",
+			expr: "scanner:*",
+			s:    "scanner:2024-01:finding",
+			want: true,
+		},
+		{
+			name: "glob star matches empty",
+			expr: "scanner:*",
+			s:    "scanner:",
+			want: true,
+		},
+		{
+			name: "glob star matches separators",
+			expr: "team/*/owner",
+			s:    "team/alpha/beta/owner",
+			want: true,
+		},
+		{
+			name: "glob question mark",
+			expr: "key?",
+			s:    "key1",
+			want: true,
+		},
+		{
+			name: "glob question mark single character",
+			expr: "key?",
+			s:    "key12",
+			want: false,
+		},
+		{
+			name: "glob regular expression metacharacters are literal",
+			expr: "a.b+c",
+			s:    "aXbbc",
+			want: false,
+		},
+		{
+			name: "regular expression",
+			expr: `/^team/[^/]+/owner$/`,
+			s:    "team/alpha/owner",
+			want: true,
+		},
+		{
+			name: "regular expression no match",
+			expr: `/^team/[^/]+/owner$/`,
+			s:    "team/alpha/beta/owner",
+			want: false,
+		},
+		{
+			name: "regular expression is not anchored",
+			expr: `/owner/`,
+			s:    "team/alpha/owner",
+			want: true,
+		},
+		{
+			name:    "invalid regular expression",
+			expr:    `/[/`,
+			wantErr: true,
+		},
+		{
+			name: "single slash is a glob",
+			expr: "/",
+			s:    "/",
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := NewPattern(testCase.expr)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("NewPattern(%q) err %t, want %t", testCase.expr, got, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := pattern.MatchString(testCase.s), testCase.want; got != want {
+				t.Errorf("MatchString(%q) = %t, want %t", testCase.s, got, want)
+			}
+		})
+	}
+}
+
+func testPattern(t *testing.T, expr string) *Pattern {
+	t.Helper()
+
+	pattern, err := NewPattern(expr)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pattern
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// PolicyEnforcement determines how tag policy violations are reported.
+type PolicyEnforcement string
+
+const (
+	PolicyEnforcementError   PolicyEnforcement = "error"
+	PolicyEnforcementWarning PolicyEnforcement = "warning"
+)
+
+func (PolicyEnforcement) Values() []PolicyEnforcement {
+	return []PolicyEnforcement{
+		PolicyEnforcementError,
+		PolicyEnforcementWarning,
+	}
+}
+
+// KeyCase is a casing rule for resource tag keys.
+type KeyCase string
+
+const (
+	KeyCaseCamel  KeyCase = "camel"
+	KeyCaseKebab  KeyCase = "kebab"
+	KeyCaseLower  KeyCase = "lower"
+	KeyCasePascal KeyCase = "pascal"
+	KeyCaseSnake  KeyCase = "snake"
+	KeyCaseUpper  KeyCase = "upper"
+)
+
+func (KeyCase) Values() []KeyCase {
+	return []KeyCase{
+		KeyCaseCamel,
+		KeyCaseKebab,
+		KeyCaseLower,
+		KeyCasePascal,
+		KeyCaseSnake,
+		KeyCaseUpper,
+	}
+}
+
+// Match returns whether the specified tag key satisfies the casing rule.
+func (c KeyCase) Match(key string) bool {
+	switch c {
+	case KeyCaseCamel:
+		return regexache.MustCompile(`^[a-z][0-9A-Za-z]*$`).MatchString(key)
+	case KeyCaseKebab:
+		return regexache.MustCompile(`^[0-9a-z]+(-[0-9a-z]+)*$`).MatchString(key)
+	case KeyCaseLower:
+		return key == strings.ToLower(key)
+	case KeyCasePascal:
+		return regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`).MatchString(key)
+	case KeyCaseSnake:
+		return regexache.MustCompile(`^[0-9a-z]+(_[0-9a-z]+)*$`).MatchString(key)
+	case KeyCaseUpper:
+		return key == strings.ToUpper(key)
+	default:
+		return true
+	}
+}
+
+// PolicyConfig contains a tag policy to enforce across all taggable resources.
+type PolicyConfig struct {
+	// AllowedValues maps tag keys to the values permitted for those keys.
+	AllowedValues map[string][]string
+	Enforcement   PolicyEnforcement
+	KeyCase       KeyCase
+	RequiredKeys  []string
+}
+
+// IsError returns whether policy violations are to be reported as errors.
+func (pc *PolicyConfig) IsError() bool {
+	if pc == nil {
+		return false
+	}
+
+	return pc.Enforcement == PolicyEnforcementError
+}
+
+// Violations returns any tag policy violations for the specified tags.
+// The violations are returned in a deterministic order.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, key := range pc.RequiredKeys {
+		if !tags.KeyExists(key) {
+			errs = append(errs, fmt.Errorf("required tag (%s) is missing", key))
+		}
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if pc.KeyCase != "" && !pc.KeyCase.Match(key) {
+			errs = append(errs, fmt.Errorf("tag key (%s) is not %s case", key, pc.KeyCase))
+		}
+
+		if allowedValues, ok := pc.AllowedValues[key]; ok {
+			var value string
+			if v := tags.KeyTagData(key); v != nil {
+				value = v.ValueString()
+			}
+
+			if !slices.Contains(allowedValues, value) {
+				errs = append(errs, fmt.Errorf("tag (%s) value (%s) is not one of: %s", key, value, strings.Join(allowedValues, ", ")))
+			}
+		}
+	}
+
+	return errs
+}
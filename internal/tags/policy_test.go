@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKeyCaseMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		keyCase KeyCase
+		key     string
+		want    bool
+	}{
+		{KeyCaseCamel, "costCenter", true},
+		{KeyCaseCamel, "CostCenter", false},
+		{KeyCaseKebab, "cost-center", true},
+		{KeyCaseKebab, "cost_center", false},
+		{KeyCaseLower, "costcenter", true},
+		{KeyCaseLower, "CostCenter", false},
+		{KeyCasePascal, "CostCenter", true},
+		{KeyCasePascal, "costCenter", false},
+		{KeyCasePascal, "Cost-Center", false},
+		{KeyCaseSnake, "cost_center", true},
+		{KeyCaseSnake, "cost__center", false},
+		{KeyCaseUpper, "COSTCENTER", true},
+		{KeyCaseUpper, "CostCenter", false},
+		{"", "anything-Goes", true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(string(testCase.keyCase)+"/"+testCase.key, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.keyCase.Match(testCase.key), testCase.want; got != want {
+				t.Errorf("Match(%q) = %t, want %t", testCase.key, got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name:         "no config",
+			policyConfig: nil,
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "required keys present",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter"},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+		},
+		{
+			name: "required keys missing",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			tags: New(ctx, map[string]string{
+				"Owner": "team",
+			}),
+			want: []string{
+				"required tag (CostCenter) is missing",
+			},
+		},
+		{
+			name: "allowed values",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"Environment": {"dev", "prod"},
+					"Tier":        {"web", "db"},
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"Environment": "qa",
+				"Tier":        "web",
+			}),
+			want: []string{
+				"tag (Environment) value (qa) is not one of: dev, prod",
+			},
+		},
+		{
+			name: "key case",
+			policyConfig: &PolicyConfig{
+				KeyCase: KeyCasePascal,
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"cost_center": "1234",
+				"owner":       "team",
+			}),
+			want: []string{
+				"tag key (cost_center) is not pascal case",
+				"tag key (owner) is not pascal case",
+			},
+		},
+		{
+			name: "all rules",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"environment": {"dev", "prod"},
+				},
+				KeyCase:      KeyCasePascal,
+				RequiredKeys: []string{"CostCenter"},
+			},
+			tags: New(ctx, map[string]string{
+				"environment": "qa",
+			}),
+			want: []string{
+				"required tag (CostCenter) is missing",
+				"tag key (environment) is not pascal case",
+				"tag (environment) value (qa) is not one of: dev, prod",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, err := range testCase.policyConfig.Violations(testCase.tags) {
+				got = append(got, err.Error())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -4,21 +4,24 @@
 package tags
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/compositeid"
 )
 
-const (
-	// Separator used in resource identifiers
-	resourceIDSeparator = `,`
-)
+// resourceID is the identifier of tag resources.
+// Tag keys may contain the separator.
+var resourceID = compositeid.ID{
+	Parts: []compositeid.Part{
+		{Name: "resource_id", Placeholder: "ID"},
+		{Name: "key", Placeholder: "KEY"},
+	},
+	LastPartMayContainSeparator: true,
+}
 
 // GetResourceID parses a given resource identifier for tag identifier and tag key.
-func GetResourceID(resourceID string) (string, string, error) {
-	parts := strings.SplitN(resourceID, resourceIDSeparator, 2)
-
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("invalid resource identifier (%[1]s), expected ID%[2]sKEY", resourceID, resourceIDSeparator)
+func GetResourceID(id string) (string, string, error) {
+	parts, err := resourceID.Parse(id)
+	if err != nil {
+		return "", "", err
 	}
 
 	return parts[0], parts[1], nil
@@ -26,8 +29,5 @@ func GetResourceID(resourceID string) (string, string, error) {
 
 // SetResourceID creates a resource identifier given a tag identifier and a tag key.
 func SetResourceID(identifier string, key string) string {
-	parts := []string{identifier, key}
-	resourceID := strings.Join(parts, resourceIDSeparator)
-
-	return resourceID
+	return resourceID.Format(identifier, key)
 }
@@ -15,6 +15,7 @@ import (
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -25,7 +26,13 @@ type Retryable func(error) (bool, error)
 
 // RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
+// If the Context carries a retry engine (the provider's `retry_budget` argument is `true`),
+// the engine's backoff policy, retry budget and circuit breaker are used. Returned errors have the same shape either way.
 func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	if engine, ok := tfretry.FromContext(ctx); ok {
+		return tfretry.When(ctx, engine, timeout, f, tfretry.Retryable(retryable))
+	}
+
 	var output interface{}
 
 	err := Retry(ctx, timeout, func() *retry.RetryError {
@@ -60,7 +67,13 @@ func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}
 // RetryGWhen is the generic version of RetryWhen which obviates the need for a type
 // assertion after the call. It retries the function `f` when the error it returns
 // satisfies `retryable`. `f` is retried until `timeout` expires.
+// If the Context carries a retry engine (the provider's `retry_budget` argument is `true`),
+// the engine's backoff policy, retry budget and circuit breaker are used. Returned errors have the same shape either way.
 func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
+	if engine, ok := tfretry.FromContext(ctx); ok {
+		return tfretry.When(ctx, engine, timeout, f, tfretry.Retryable(retryable))
+	}
+
 	var output T
 
 	err := Retry(ctx, timeout, func() *retry.RetryError {
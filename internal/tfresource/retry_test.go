@@ -4,6 +4,7 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync/atomic"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -373,6 +375,80 @@ func TestRetryUntilNotFound(t *testing.T) { //nolint:tparallel
 	}
 }
 
+func TestRetryGWhen_errorShape(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	errTest := errors.New("test")
+
+	contexts := map[string]context.Context{
+		"no retry engine":  ctx,
+		"nil retry engine": tfretry.NewContext(ctx, nil, "test"),
+		"retry engine":     tfretry.NewContext(ctx, tfretry.NewEngine(), "test"),
+	}
+
+	for name, ctx := range contexts {
+		ctx := ctx
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("non-retryable error", func(t *testing.T) {
+				t.Parallel()
+
+				_, err := tfresource.RetryGWhen(ctx, time.Second, func() (any, error) {
+					return nil, errTest
+				}, func(err error) (bool, error) {
+					return false, err
+				})
+
+				if err != errTest { //nolint: errorlint // We are actually comparing equality
+					t.Fatalf("got %#v, want %#v", err, errTest)
+				}
+			})
+
+			t.Run("retryable error timeout", func(t *testing.T) {
+				t.Parallel()
+
+				_, err := tfresource.RetryGWhen(ctx, 100*time.Millisecond, func() (any, error) {
+					return nil, errTest
+				}, func(err error) (bool, error) {
+					return err != nil, err
+				})
+
+				if err != errTest { //nolint: errorlint // We are actually comparing equality
+					t.Fatalf("got %#v, want %#v", err, errTest)
+				}
+				if tfresource.TimedOut(err) {
+					t.Fatal("unexpected timeout error")
+				}
+			})
+
+			t.Run("retryable error success", func(t *testing.T) {
+				t.Parallel()
+
+				var calls int32
+				output, err := tfresource.RetryGWhen(ctx, 5*time.Second, func() (int32, error) {
+					n := atomic.AddInt32(&calls, 1)
+					if n < 3 {
+						return 0, errTest
+					}
+
+					return n, nil
+				}, func(err error) (bool, error) {
+					return err != nil, err
+				})
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got, want := output, int32(3); got != want {
+					t.Errorf("output: got %d, want %d", got, want)
+				}
+			})
+		})
+	}
+}
+
 func TestRetryContext_error(t *testing.T) {
 	ctx := acctest.Context(t)
 	t.Parallel()
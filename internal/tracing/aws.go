@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddSDKv1Handlers adds request handlers that wrap each AWS SDK for Go v1 API call in a span.
+func AddSDKv1Handlers(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "TerraformProviderAWS.TracingStart",
+		Fn:   startSDKv1Span,
+	})
+	// Complete handlers are run once per call, after any retries, whether or not the call succeeded.
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "TerraformProviderAWS.TracingEnd",
+		Fn:   endSDKv1Span,
+	})
+}
+
+func startSDKv1Span(r *request.Request) {
+	serviceID := r.ClientInfo.ServiceID
+	operation := r.Operation.Name
+
+	ctx, _ := Tracer().Start(r.Context(), fmt.Sprintf("%s.%s", serviceID, operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "aws-api"),
+			attribute.String("rpc.service", serviceID),
+			attribute.String("rpc.method", operation),
+			attribute.String("aws.region", aws_sdkv1.StringValue(r.Config.Region)),
+		),
+	)
+
+	r.SetContext(ctx)
+}
+
+func endSDKv1Span(r *request.Request) {
+	span := trace.SpanFromContext(r.Context())
+
+	span.SetAttributes(AttrKeyRetryCount.Int(r.RetryCount))
+
+	if r.RequestID != "" {
+		span.SetAttributes(attribute.String("aws.request_id", r.RequestID))
+	}
+
+	if r.HTTPResponse != nil {
+		span.SetAttributes(attribute.Int("http.status_code", r.HTTPResponse.StatusCode))
+	}
+
+	if err := r.Error; err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) {
+			span.SetAttributes(AttrKeyErrorCode.String(awsErr.Code()))
+		}
+
+		setError(span, err)
+	}
+
+	span.End()
+}
+
+// AddSDKv2Middlewares adds middleware that wraps each AWS SDK for Go v2 API call in a span.
+func AddSDKv2Middlewares(stack *middleware.Stack) error {
+	// Added after the service metadata middleware so that the service ID and operation name are available.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformProviderAWS.Tracing", handleSDKv2Initialize), middleware.After)
+}
+
+func handleSDKv2Initialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	serviceID := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	ctx, span := Tracer().Start(ctx, fmt.Sprintf("%s.%s", serviceID, operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "aws-api"),
+			attribute.String("rpc.service", serviceID),
+			attribute.String("rpc.method", operation),
+			attribute.String("aws.region", awsmiddleware.GetRegion(ctx)),
+		),
+	)
+	defer span.End()
+
+	// The retry middleware runs within this middleware, so the results of all attempts are available.
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if results, ok := retry.GetAttemptResults(metadata); ok && len(results.Results) > 0 {
+		span.SetAttributes(AttrKeyRetryCount.Int(len(results.Results) - 1))
+	}
+
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok && requestID != "" {
+		span.SetAttributes(attribute.String("aws.request_id", requestID))
+	}
+
+	if response, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+		span.SetAttributes(attribute.Int("http.status_code", response.StatusCode))
+	}
+
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(AttrKeyErrorCode.String(apiErr.ErrorCode()))
+		}
+
+		setError(span, err)
+	}
+
+	return out, metadata, err
+}
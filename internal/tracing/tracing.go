@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvVarEndpoint is the URL of an OTLP/HTTP trace collector, e.g. `http://localhost:4318/v1/traces`.
+	// Tracing is disabled if the environment variable is not set.
+	EnvVarEndpoint = "TF_AWS_TRACING_ENDPOINT"
+
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+)
+
+// Span attribute keys.
+const (
+	AttrKeyErrorCode      = attribute.Key("aws.error_code")
+	AttrKeyOperation      = attribute.Key("terraform.operation")
+	AttrKeyResourceType   = attribute.Key("terraform.resource_type")
+	AttrKeyRetryCount     = attribute.Key("aws.retry_count")
+	AttrKeyServicePackage = attribute.Key("terraform.service_package")
+)
+
+// Start configures the global OpenTelemetry tracer provider to export spans to the OTLP endpoint
+// specified by the TF_AWS_TRACING_ENDPOINT environment variable.
+// The returned function flushes any buffered spans and must be called before the provider exits.
+func Start(ctx context.Context) (func(context.Context) error, error) {
+	endpoint := os.Getenv(EnvVarEndpoint)
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter (%s): %w", endpoint, err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "terraform-provider-aws"),
+			attribute.String("service.version", version.ProviderVersion),
+		)),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// Tracer returns the provider's tracer.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName, trace.WithInstrumentationVersion(version.ProviderVersion))
+}
+
+// StartOperation starts a span for a CRUD operation on the specified resource type.
+func StartOperation(ctx context.Context, servicePackageName, typeName, operation string) context.Context {
+	ctx, _ = Tracer().Start(ctx, fmt.Sprintf("%s.%s", typeName, operation), trace.WithAttributes(
+		AttrKeyOperation.String(operation),
+		AttrKeyResourceType.String(typeName),
+		AttrKeyServicePackage.String(servicePackageName),
+	))
+
+	return ctx
+}
+
+// EndOperation ends the CRUD operation span started by StartOperation, recording any error.
+func EndOperation(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+
+	setError(span, err)
+	span.End()
+}
+
+func setError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	spanRecorder     *tracetest.SpanRecorder
+	spanRecorderOnce sync.Once
+)
+
+// endedSpan returns the recorded ended span with the specified name.
+func endedSpan(t *testing.T, name string) sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	for _, span := range spanRecorder.Ended() {
+		if span.Name() == name {
+			return span
+		}
+	}
+
+	t.Fatalf("span (%s) not found", name)
+
+	return nil
+}
+
+func setTracerProvider() {
+	spanRecorderOnce.Do(func() {
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	})
+}
+
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, v := range span.Attributes() {
+		if v.Key == key {
+			return v.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+func TestOperationSpan(t *testing.T) {
+	t.Parallel()
+
+	setTracerProvider()
+
+	ctx := tracing.StartOperation(context.Background(), "ec2", "aws_vpc", "Create")
+	tracing.EndOperation(ctx, errors.New("creating EC2 VPC: boom"))
+
+	span := endedSpan(t, "aws_vpc.Create")
+
+	if got, want := attributeValue(span, tracing.AttrKeyServicePackage).AsString(), "ec2"; got != want {
+		t.Errorf("service package: got %s, want %s", got, want)
+	}
+	if got, want := attributeValue(span, tracing.AttrKeyResourceType).AsString(), "aws_vpc"; got != want {
+		t.Errorf("resource type: got %s, want %s", got, want)
+	}
+	if got, want := attributeValue(span, tracing.AttrKeyOperation).AsString(), "Create"; got != want {
+		t.Errorf("operation: got %s, want %s", got, want)
+	}
+	if got, want := span.Status().Code, codes.Error; got != want {
+		t.Errorf("status: got %s, want %s", got, want)
+	}
+}
+
+func TestSDKv1Handlers(t *testing.T) {
+	t.Parallel()
+
+	setTracerProvider()
+
+	ctx := tracing.StartOperation(context.Background(), "ec2", "aws_instance", "Create")
+
+	r := request.New(
+		aws_sdkv1.Config{Region: aws_sdkv1.String("us-west-2")}, //lintignore:AWSAT003
+		metadata.ClientInfo{ServiceID: "EC2"},
+		request.Handlers{},
+		nil,
+		&request.Operation{Name: "RunInstances", HTTPMethod: "POST", HTTPPath: "/"},
+		nil,
+		nil,
+	)
+	r.SetContext(ctx)
+	tracing.AddSDKv1Handlers(&r.Handlers)
+
+	r.Handlers.Validate.Run(r)
+	r.RetryCount = 2
+	r.Error = awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)
+	r.Handlers.Complete.Run(r)
+
+	tracing.EndOperation(ctx, nil)
+
+	span := endedSpan(t, "EC2.RunInstances")
+
+	if got, want := span.Parent().SpanID(), endedSpan(t, "aws_instance.Create").SpanContext().SpanID(); got != want {
+		t.Errorf("parent span: got %s, want %s", got, want)
+	}
+	if got, want := attributeValue(span, tracing.AttrKeyRetryCount).AsInt64(), int64(2); got != want {
+		t.Errorf("retry count: got %d, want %d", got, want)
+	}
+	if got, want := attributeValue(span, tracing.AttrKeyErrorCode).AsString(), "RequestLimitExceeded"; got != want {
+		t.Errorf("error code: got %s, want %s", got, want)
+	}
+	if got, want := span.Status().Code, codes.Error; got != want {
+		t.Errorf("status: got %s, want %s", got, want)
+	}
+}
+
+func TestSDKv2Middlewares(t *testing.T) {
+	t.Parallel()
+
+	setTracerProvider()
+
+	ctx := tracing.StartOperation(context.Background(), "ec2", "aws_vpc", "Read")
+
+	stack := middleware.NewStack("DescribeVpcs", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "EC2",
+		Region:        "us-west-2", //lintignore:AWSAT003
+		OperationName: "DescribeVpcs",
+	}, middleware.Before); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracing.AddSDKv2Middlewares(stack); err != nil {
+		t.Fatal(err)
+	}
+	retryer := retry.NewStandard(func(o *retry.StandardOptions) {
+		o.MaxAttempts = 3
+		o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+			return 0, nil
+		})
+	})
+	if err := stack.Finalize.Add(retry.NewAttemptMiddleware(retryer, func(r interface{}) interface{} { return r }), middleware.After); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, interface{}) (interface{}, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, &smithy.GenericAPIError{Code: "RequestLimitExceeded", Message: "Request limit exceeded."}
+	}), stack)
+
+	if _, _, err := handler.Handle(ctx, struct{}{}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	tracing.EndOperation(ctx, nil)
+
+	span := endedSpan(t, "EC2.DescribeVpcs")
+
+	if got, want := span.Parent().SpanID(), endedSpan(t, "aws_vpc.Read").SpanContext().SpanID(); got != want {
+		t.Errorf("parent span: got %s, want %s", got, want)
+	}
+	if got, want := attributeValue(span, tracing.AttrKeyRetryCount).AsInt64(), int64(2); got != want {
+		t.Errorf("retry count: got %d, want %d", got, want)
+	}
+	if got, want := attributeValue(span, tracing.AttrKeyErrorCode).AsString(), "RequestLimitExceeded"; got != want {
+		t.Errorf("error code: got %s, want %s", got, want)
+	}
+	if got, want := span.Status().Code, codes.Error; got != want {
+		t.Errorf("status: got %s, want %s", got, want)
+	}
+}
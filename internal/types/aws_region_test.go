@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsAWSRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	for _, tc := range []struct {
+		region string
+		valid  bool
+	}{
+		{"us-west-2", true},      //lintignore:AWSAT003
+		{"us-gov-west-1", true},  //lintignore:AWSAT003
+		{"cn-northwest-1", true}, //lintignore:AWSAT003
+		{"us-isob-east-1", true}, //lintignore:AWSAT003
+		{"aws-global", false},
+		{"us-west", false},
+		{"example.com", false},
+		{"", false},
+	} {
+		ok := IsAWSRegion(tc.region)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsAWSRegion(%q) = %v, want %v", tc.region, got, want)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package compositeid implements resource identifiers composed of several attribute values,
+// e.g. "rtb-0123456789abcdef0,10.0.0.0/16".
+package compositeid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// DefaultSeparator separates the parts of identifiers that do not declare a separator.
+	DefaultSeparator = ","
+)
+
+// Validator validates the value of an identifier part.
+type Validator func(string) error
+
+// Part is a part of a composite identifier.
+type Part struct {
+	// Name is the name of the Terraform attribute that the part's value is imported into, e.g. "route_table_id".
+	Name string
+	// Placeholder names the part in error messages. It defaults to Name in upper case, e.g. "ROUTE_TABLE_ID".
+	Placeholder string
+	// Optional parts may be empty.
+	Optional bool
+	// Validators validate the part's value when an identifier is parsed.
+	Validators []Validator
+}
+
+func (p Part) placeholder() string {
+	if p.Placeholder != "" {
+		return p.Placeholder
+	}
+
+	return strings.ToUpper(p.Name)
+}
+
+// ID declares the format of a composite identifier.
+//
+// Identifiers can also be parsed from a JSON object keyed by part name, e.g.
+// `{"route_table_id":"rtb-0123456789abcdef0","destination_cidr_block":"10.0.0.0/16"}`,
+// so that Terraform `import` blocks can declare the parts with `jsonencode`.
+type ID struct {
+	// Parts are the identifier's parts, in order.
+	Parts []Part
+	// Separator separates the identifier's parts. It defaults to DefaultSeparator.
+	Separator string
+	// LastPartMayContainSeparator is set if the last part's value may contain the separator, e.g. a tag key.
+	LastPartMayContainSeparator bool
+}
+
+func (id ID) separator() string {
+	if id.Separator != "" {
+		return id.Separator
+	}
+
+	return DefaultSeparator
+}
+
+// Names returns the names of the identifier's parts, in order.
+func (id ID) Names() []string {
+	return tfslices.ApplyToAll(id.Parts, func(p Part) string {
+		return p.Name
+	})
+}
+
+// Expected returns the identifier's format, e.g. "ROUTE_TABLE_ID,DESTINATION_CIDR_BLOCK".
+func (id ID) Expected() string {
+	return strings.Join(tfslices.ApplyToAll(id.Parts, Part.placeholder), id.separator())
+}
+
+// Format returns the identifier for the specified part values, in order.
+// Values are not validated.
+func (id ID) Format(values ...string) string {
+	return strings.Join(values, id.separator())
+}
+
+// Parse returns the part values, in order, of the specified identifier.
+func (id ID) Parse(s string) ([]string, error) {
+	var values []string
+
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		v, err := id.parseJSON(s)
+		if err != nil {
+			return nil, err
+		}
+		values = v
+	} else {
+		n := len(id.Parts)
+		if !id.LastPartMayContainSeparator {
+			n = -1
+		}
+		values = strings.SplitN(s, id.separator(), n)
+	}
+
+	if len(values) != len(id.Parts) {
+		return nil, id.newError(s)
+	}
+
+	for i, part := range id.Parts {
+		v := values[i]
+
+		if v == "" {
+			if part.Optional {
+				continue
+			}
+
+			return nil, id.newError(s)
+		}
+
+		for _, validate := range part.Validators {
+			if err := validate(v); err != nil {
+				return nil, fmt.Errorf("invalid resource identifier (%s), %s (%s): %w", s, part.placeholder(), v, err)
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// Map returns the part values of the specified identifier, keyed by part name.
+func (id ID) Map(s string) (map[string]string, error) {
+	values, err := id.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string, len(values))
+	for i, part := range id.Parts {
+		m[part.Name] = values[i]
+	}
+
+	return m, nil
+}
+
+func (id ID) parseJSON(s string) ([]string, error) {
+	var m map[string]string
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil, fmt.Errorf("invalid resource identifier (%s), expected a JSON object with keys %s: %w", s, strings.Join(id.Names(), ", "), err)
+	}
+
+	values := make([]string, len(id.Parts))
+	for i, part := range id.Parts {
+		v, ok := m[part.Name]
+		if !ok && !part.Optional {
+			return nil, fmt.Errorf("invalid resource identifier (%s), missing key %q", s, part.Name)
+		}
+		values[i] = v
+		delete(m, part.Name)
+	}
+
+	if len(m) > 0 {
+		keys := tfmaps.Keys(m)
+		slices.Sort(keys)
+
+		return nil, fmt.Errorf("invalid resource identifier (%s), unexpected keys %s", s, strings.Join(keys, ", "))
+	}
+
+	return values, nil
+}
+
+func (id ID) newError(s string) error {
+	return fmt.Errorf("invalid resource identifier (%s), expected %s", s, id.Expected())
+}
+
+// AccountID validates that a part's value is an AWS account ID.
+func AccountID(s string) error {
+	if !itypes.IsAWSAccountID(s) {
+		return errors.New("must be an AWS account ID")
+	}
+
+	return nil
+}
+
+// ARN validates that a part's value is an ARN.
+func ARN(s string) error {
+	if !arn.IsARN(s) {
+		return errors.New("must be an ARN")
+	}
+
+	return nil
+}
+
+// Int validates that a part's value is an integer.
+func Int(s string) error {
+	if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+		return errors.New("must be an integer")
+	}
+
+	return nil
+}
+
+// Region validates that a part's value is an AWS Region.
+func Region(s string) error {
+	if !itypes.IsAWSRegion(s) {
+		return errors.New("must be an AWS Region")
+	}
+
+	return nil
+}
+
+// OneOf returns a validator that validates that a part's value is one of the specified values.
+func OneOf(values ...string) Validator {
+	return func(s string) error {
+		if !slices.Contains(values, s) {
+			return fmt.Errorf("must be one of %s", strings.Join(values, ", "))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compositeid
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIDParse(t *testing.T) {
+	t.Parallel()
+
+	routeID := ID{
+		Parts: []Part{
+			{Name: "route_table_id"},
+			{Name: "destination_cidr_block"},
+		},
+	}
+	permissionID := ID{
+		Parts: []Part{
+			{Name: "account_id", Validators: []Validator{AccountID}},
+			{Name: "statement_id", Optional: true},
+			{Name: "priority", Validators: []Validator{Int}},
+		},
+		Separator: "/",
+	}
+	tagID := ID{
+		Parts: []Part{
+			{Name: "resource_id", Placeholder: "ID"},
+			{Name: "key", Placeholder: "KEY"},
+		},
+		LastPartMayContainSeparator: true,
+	}
+
+	testCases := map[string]struct {
+		id            ID
+		input         string
+		expected      []string
+		expectedError string
+	}{
+		"valid": {
+			id:       routeID,
+			input:    "rtb-0123456789abcdef0,10.0.0.0/16",
+			expected: []string{"rtb-0123456789abcdef0", "10.0.0.0/16"},
+		},
+		"empty": {
+			id:            routeID,
+			input:         "",
+			expectedError: "invalid resource identifier (), expected ROUTE_TABLE_ID,DESTINATION_CIDR_BLOCK",
+		},
+		"too many parts": {
+			id:            routeID,
+			input:         "rtb-0123456789abcdef0,10.0.0.0/16,extra",
+			expectedError: "invalid resource identifier (rtb-0123456789abcdef0,10.0.0.0/16,extra), expected ROUTE_TABLE_ID,DESTINATION_CIDR_BLOCK",
+		},
+		"empty part": {
+			id:            routeID,
+			input:         ",10.0.0.0/16",
+			expectedError: "invalid resource identifier (,10.0.0.0/16), expected ROUTE_TABLE_ID,DESTINATION_CIDR_BLOCK",
+		},
+		"wrong separator": {
+			id:            routeID,
+			input:         "rtb-0123456789abcdef0;10.0.0.0/16",
+			expectedError: "invalid resource identifier (rtb-0123456789abcdef0;10.0.0.0/16), expected ROUTE_TABLE_ID,DESTINATION_CIDR_BLOCK",
+		},
+		"optional part": {
+			id:       permissionID,
+			input:    "123456789012//10",
+			expected: []string{"123456789012", "", "10"},
+		},
+		"validator": {
+			id:            permissionID,
+			input:         "123456789012/s1/high",
+			expectedError: "invalid resource identifier (123456789012/s1/high), PRIORITY (high): must be an integer",
+		},
+		"last part contains separator": {
+			id:       tagID,
+			input:    "vpc-0123456789abcdef0,key,with,commas",
+			expected: []string{"vpc-0123456789abcdef0", "key,with,commas"},
+		},
+		"placeholders": {
+			id:            tagID,
+			input:         "vpc-0123456789abcdef0,",
+			expectedError: "invalid resource identifier (vpc-0123456789abcdef0,), expected ID,KEY",
+		},
+		"JSON": {
+			id:       routeID,
+			input:    `{"destination_cidr_block":"10.0.0.0/16","route_table_id":"rtb-0123456789abcdef0"}`,
+			expected: []string{"rtb-0123456789abcdef0", "10.0.0.0/16"},
+		},
+		"JSON optional part": {
+			id:       permissionID,
+			input:    `{"account_id":"123456789012","priority":"10"}`,
+			expected: []string{"123456789012", "", "10"},
+		},
+		"JSON missing key": {
+			id:            routeID,
+			input:         `{"route_table_id":"rtb-0123456789abcdef0"}`,
+			expectedError: `invalid resource identifier ({"route_table_id":"rtb-0123456789abcdef0"}), missing key "destination_cidr_block"`,
+		},
+		"JSON unexpected keys": {
+			id:            routeID,
+			input:         `{"destination_cidr_block":"10.0.0.0/16","route_table_id":"rtb-0123456789abcdef0","vpc_id":"vpc-1","gateway_id":"igw-1"}`,
+			expectedError: `invalid resource identifier ({"destination_cidr_block":"10.0.0.0/16","route_table_id":"rtb-0123456789abcdef0","vpc_id":"vpc-1","gateway_id":"igw-1"}), unexpected keys gateway_id, vpc_id`,
+		},
+		"JSON validator": {
+			id:            permissionID,
+			input:         `{"account_id":"1234","priority":"10"}`,
+			expectedError: `invalid resource identifier ({"account_id":"1234","priority":"10"}), ACCOUNT_ID (1234): must be an AWS account ID`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.id.Parse(testCase.input)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got no error", testCase.expectedError)
+				}
+				if got := err.Error(); got != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIDFormat(t *testing.T) {
+	t.Parallel()
+
+	id := ID{
+		Parts: []Part{
+			{Name: "account_id"},
+			{Name: "name"},
+		},
+		Separator: "/",
+	}
+
+	if got, want := id.Format("123456789012", "example"), "123456789012/example"; got != want {
+		t.Errorf("Format() = %s, want %s", got, want)
+	}
+
+	got, err := id.Map("123456789012/example")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, map[string]string{"account_id": "123456789012", "name": "example"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -17,12 +17,18 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level AWS Region information.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled bool // Does the resource or data source have the per-resource `region` argument?
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
 	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
 	Name    string
 	Tags    *ServicePackageResourceTags
+	Region  *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -31,6 +37,7 @@ type ServicePackageFrameworkResource struct {
 	Factory func(context.Context) (resource.ResourceWithConfigure, error)
 	Name    string
 	Tags    *ServicePackageResourceTags
+	Region  *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -40,6 +47,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -49,4 +57,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
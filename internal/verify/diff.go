@@ -5,9 +5,11 @@ package verify
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -21,7 +23,16 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// The merged tags are then checked against any provider-level tag policy.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := setTagsAllDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	return checkTagPolicyDiff(ctx, diff, meta)
+}
+
+func setTagsAllDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -103,6 +114,47 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// checkTagPolicyDiff checks the merger of resource tags on to those defined at the provider-level
+// against the provider-level tag policy.
+// Violations are returned as an error if the policy is enforced, otherwise they are logged.
+// CustomizeDiff functions can't return warnings; the tags interceptor reports them as warning diagnostics after apply.
+func checkTagPolicyDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	policyConfig := meta.(*conns.AWSClient).TagPolicyConfig
+
+	if policyConfig == nil {
+		return nil
+	}
+
+	// Tags can't be checked until they are known.
+	if !diff.GetRawPlan().GetAttr("tags").IsWhollyKnown() {
+		return nil
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
+	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
+	violations := policyConfig.Violations(allTags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if policyConfig.IsError() {
+		return fmt.Errorf("tag policy violation: %w", errors.Join(violations...))
+	}
+
+	for _, err := range violations {
+		tflog.Warn(ctx, "tag policy violation", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return nil
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
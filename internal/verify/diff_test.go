@@ -4,8 +4,16 @@
 package verify
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentRoundedTime(t *testing.T) {
@@ -60,3 +68,146 @@ func TestSuppressEquivalentRoundedTime(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckTagPolicyDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := func(enforcement tftags.PolicyEnforcement) *tftags.PolicyConfig {
+		return &tftags.PolicyConfig{
+			Enforcement:  enforcement,
+			KeyCase:      tftags.KeyCasePascal,
+			RequiredKeys: []string{"Owner"},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		policyConfig      *tftags.PolicyConfig
+		defaultTagsConfig *tftags.DefaultConfig
+		ignoreTagsConfig  *tftags.IgnoreConfig
+		tags              map[string]string
+		wantErr           string
+	}{
+		{
+			name: "no policy",
+			tags: map[string]string{"cost-center": "x"},
+		},
+		{
+			name:         "compliant",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			tags:         map[string]string{"Owner": "me"},
+		},
+		{
+			name:         "required key from default tags",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			defaultTagsConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{"Owner": "me"}),
+			},
+			tags: map[string]string{"CostCenter": "x"},
+		},
+		{
+			name:         "violating key ignored",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			ignoreTagsConfig: &tftags.IgnoreConfig{
+				Keys: tftags.New(ctx, []string{"cost-center"}),
+			},
+			tags: map[string]string{"Owner": "me", "cost-center": "x"},
+		},
+		{
+			name:         "violation warning",
+			policyConfig: policyConfig(tftags.PolicyEnforcementWarning),
+			tags:         map[string]string{"cost-center": "x"},
+		},
+		{
+			name:         "violation error",
+			policyConfig: policyConfig(tftags.PolicyEnforcementError),
+			tags:         map[string]string{"cost-center": "x"},
+			wantErr:      "tag policy violation: required tag (Owner) is missing\ntag key (cost-center) is not pascal case",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCheckTagPolicyDiff(ctx, testCase.tags, &conns.AWSClient{
+				DefaultTagsConfig: testCase.defaultTagsConfig,
+				IgnoreTagsConfig:  testCase.ignoreTagsConfig,
+				TagPolicyConfig:   testCase.policyConfig,
+			})
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Fatal("expected error")
+			} else if got, want := err.Error(), testCase.wantErr; !strings.Contains(got, want) {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+
+	t.Run("unknown tags", func(t *testing.T) {
+		t.Parallel()
+
+		err := testCheckTagPolicyDiffRawPlan(ctx, nil, cty.UnknownVal(cty.Map(cty.String)), &conns.AWSClient{
+			TagPolicyConfig: policyConfig(tftags.PolicyEnforcementError),
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+}
+
+func testCheckTagPolicyDiff(ctx context.Context, tags map[string]string, meta any) error {
+	config := make(map[string]any)
+	planTags := make(map[string]cty.Value)
+	for k, v := range tags {
+		config[k] = v
+		planTags[k] = cty.StringVal(v)
+	}
+
+	plan := cty.MapValEmpty(cty.String)
+	if len(planTags) > 0 {
+		plan = cty.MapVal(planTags)
+	}
+
+	return testCheckTagPolicyDiffRawPlan(ctx, config, plan, meta)
+}
+
+func testCheckTagPolicyDiffRawPlan(ctx context.Context, tags map[string]any, plan cty.Value, meta any) error {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: checkTagPolicyDiff,
+	}
+
+	config := make(map[string]any)
+	if tags != nil {
+		config["tags"] = tags
+	}
+
+	state := &terraform.InstanceState{
+		RawPlan: cty.ObjectVal(map[string]cty.Value{
+			"tags": plan,
+		}),
+	}
+
+	_, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+
+	return err
+}
@@ -10,13 +10,22 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Start(ctx)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 	if err != nil {
 		log.Fatal(err)
@@ -38,6 +47,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Flush any buffered spans before exiting.
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] shutting down tracing: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -191,6 +191,32 @@ func IsOptInRegion(region string) bool {
 	}
 }
 
+// IsGlobalService returns whether the specified service package's resources are global,
+// i.e. not specific to an AWS Region.
+func IsGlobalService(servicePackageName string) bool {
+	switch servicePackageName {
+	case Account,
+		BCMDataExports,
+		Budgets,
+		CE,
+		CloudFront, CloudFrontKeyValueStore,
+		CostOptimizationHub,
+		CUR,
+		GlobalAccelerator,
+		IAM,
+		NetworkManager,
+		Organizations,
+		Pricing,
+		Route53, Route53Domains, Route53RecoveryControlConfig, Route53RecoveryReadiness,
+		Shield,
+		WAF,
+		"meta": // Provider-level data sources, e.g. aws_partition.
+		return true
+	default:
+		return false
+	}
+}
+
 func PartitionForRegion(region string) string {
 	switch region {
 	case "":
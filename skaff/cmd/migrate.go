@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateFile     string
+	providerVersion string
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate a Terraform Plugin SDK V2 resource to the Terraform Plugin Framework",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resource.Migrate(migrateFile, name, providerVersion, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.Flags().StringVar(&migrateFile, "file", "", "Terraform Plugin SDK V2 resource source file (e.g., internal/service/accessanalyzer/analyzer.go)")
+	migrateCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	migrateCmd.Flags().StringVarP(&name, "name", "n", "", "if skaff doesn't get it right, explicitly give name of the entity (e.g., DBInstance)")
+	migrateCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	migrateCmd.Flags().StringVar(&providerVersion, "provider-version", "", "provider version with the Terraform Plugin SDK V2 resource used by the state compatibility test (defaults to the last release in CHANGELOG.md)")
+	migrateCmd.MarkFlagRequired("file")
+}
@@ -16,12 +16,17 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	fromSDK       string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if fromSDK != "" {
+			return resource.CreateFromSDK(fromSDK, name, snakeName, !clearComments, force)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +40,5 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&fromSDK, "from-sdk", "", "generate a Terraform Plugin Framework resource from the AWS Go SDK v2 shapes of a create operation (e.g., accessanalyzer:CreateAnalyzer)")
 }
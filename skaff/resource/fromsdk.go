@@ -0,0 +1,963 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
+)
+
+//go:embed resourcefromsdk.tmpl
+var resourceFromSDKTmpl string
+
+// FromSDKTemplateData is the data used to generate a resource from the shapes of AWS SDK for Go v2 API operations.
+type FromSDKTemplateData struct {
+	TemplateData
+
+	SDKPackage string // AWS SDK for Go v2 service package name, e.g. "accessanalyzer".
+
+	Attributes  []string // Top-level schema attributes.
+	Blocks      []string // Top-level schema blocks.
+	ModelFields []string // Top-level model struct fields.
+	Models      []string // Nested model struct declarations.
+
+	UnionMembers []string // AutoFlex union member registrations, e.g. "awstypes.FilterMemberTag{}".
+
+	CreateOperation string
+	ClientToken     bool
+	IDField         string // Create input or output field holding the resource's identifier.
+	IDFromOutput    bool
+	NameField       string // Top-level model field used in error messages, if any.
+
+	FindOperation   string
+	FindInputField  string
+	FindResultField string // Finder output field holding the resource's description, if any.
+	FindResultType  string
+	FindTags        bool // Whether the finder's result includes the resource's tags.
+
+	UpdateOperation  string
+	UpdateInputField string
+	UpdateFields     []string // Top-level model fields that can be updated in-place.
+
+	DeleteOperation   string
+	DeleteInputField  string
+	NotFoundException string
+
+	StatusField   string
+	CreatePending []string
+	CreateTarget  []string
+	UpdatePending []string
+	DeletePending []string
+
+	TODOs []string
+}
+
+// FinderName returns the name of the resource's finder function, e.g. "findAnalyzerByName".
+func (td FromSDKTemplateData) FinderName() string {
+	key := modelFieldName(td.FindInputField)
+	if v := strings.TrimPrefix(key, td.Resource); v != "" {
+		key = v
+	}
+
+	return fmt.Sprintf("find%sBy%s", td.Resource, key)
+}
+
+func (td FromSDKTemplateData) CreateWaiter() bool {
+	return len(td.CreatePending) > 0
+}
+
+func (td FromSDKTemplateData) UpdateWaiter() bool {
+	return td.UpdateOperation != "" && len(td.UpdatePending) > 0
+}
+
+func (td FromSDKTemplateData) DeleteWaiter() bool {
+	return len(td.DeletePending) > 0
+}
+
+func (td FromSDKTemplateData) Timeouts() bool {
+	return td.CreateWaiter() || td.UpdateWaiter() || td.DeleteWaiter()
+}
+
+// CreateFromSDK creates scaffolding for a Terraform Plugin Framework resource from the shapes of the
+// AWS SDK for Go v2 service's API operations.
+// from is of the form `<service>:<CreateOperation>`, e.g. `accessanalyzer:CreateAnalyzer`.
+// The resource name defaults to the create operation's name without its "Create" prefix.
+func CreateFromSDK(from, resName, snakeName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	service, operation, ok := strings.Cut(from, ":")
+	if !ok || service == "" || operation == "" {
+		return fmt.Errorf("error checking: --from-sdk should be of the form <service>:<CreateOperation> (e.g., accessanalyzer:CreateAnalyzer)")
+	}
+
+	if resName == "" {
+		resName = strings.TrimPrefix(operation, "Create")
+	}
+
+	if resName == "" || resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	dir, err := shape.Dir(service)
+	if err != nil {
+		return fmt.Errorf("error finding AWS SDK for Go v2 service package: %w", err)
+	}
+
+	pkg, err := shape.Load(dir)
+	if err != nil {
+		return fmt.Errorf("error loading AWS SDK for Go v2 service package: %w", err)
+	}
+
+	snakeName = convert.ToSnakeCase(resName, snakeName)
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting human-friendly name: %w", err)
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceLower:        strings.ToLower(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: hf,
+		IncludeComments:      comments,
+		ServicePackage:       servicePackage,
+		Service:              s,
+		ServiceLower:         strings.ToLower(s),
+		AWSServiceName:       sn,
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	fromSDKTemplateData, err := newFromSDKTemplateData(pkg, operation, templateData)
+	if err != nil {
+		return err
+	}
+	templateData.IncludeTags = fromSDKTemplateData.IncludeTags
+
+	contents, err := executeFromSDKTemplate(fromSDKTemplateData)
+	if err != nil {
+		return fmt.Errorf("generating resource: %w", err)
+	}
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if _, err := os.Stat(f); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", f)
+	}
+
+	if err := os.WriteFile(f, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", f, err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	for _, v := range fromSDKTemplateData.TODOs {
+		fmt.Fprintf(os.Stderr, "TODO: %s\n", v)
+	}
+
+	return nil
+}
+
+// executeFromSDKTemplate executes the resource template, removes unused imports and formats the result.
+func executeFromSDKTemplate(td *FromSDKTemplateData) ([]byte, error) {
+	tmpl, err := template.New("newresfromsdk").Parse(resourceFromSDKTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	src, err := removeUnusedImports(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generated file: %s", err)
+	}
+
+	contents, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	return contents, nil
+}
+
+// removeUnusedImports removes the lines of import specs whose package name is not referenced in the source.
+// The template imports every package that the generated code may need.
+func removeUnusedImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if v, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := v.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	unused := make(map[int]bool)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if !used[name] {
+			unused[fset.Position(spec.Pos()).Line] = true
+		}
+	}
+
+	var output bytes.Buffer
+	for i, line := range bytes.SplitAfter(src, []byte("\n")) {
+		if !unused[i+1] {
+			output.Write(line)
+		}
+	}
+
+	return output.Bytes(), nil
+}
+
+// fromSDKGenerator builds a resource's schema and models from AWS SDK for Go v2 shapes.
+type fromSDKGenerator struct {
+	td     *FromSDKTemplateData
+	models map[string]bool // Names of generated nested models.
+}
+
+// newFromSDKTemplateData returns the template data for a resource created by the specified API operation.
+func newFromSDKTemplateData(pkg *shape.Package, createOperation string, td TemplateData) (*FromSDKTemplateData, error) {
+	if !pkg.HasOperation(createOperation) {
+		return nil, fmt.Errorf("operation %s not found in AWS SDK for Go v2 service package %s", createOperation, pkg.Name)
+	}
+
+	createInput, err := pkg.Input(createOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	createOutput, err := pkg.Output(createOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	noun := strings.TrimPrefix(createOperation, "Create")
+
+	g := &fromSDKGenerator{
+		td: &FromSDKTemplateData{
+			TemplateData:    td,
+			SDKPackage:      pkg.Name,
+			CreateOperation: createOperation,
+		},
+		models: make(map[string]bool),
+	}
+
+	// Finder.
+	for _, v := range []string{"Get" + noun, "Describe" + noun} {
+		if pkg.HasOperation(v) {
+			g.td.FindOperation = v
+			break
+		}
+	}
+	if g.td.FindOperation == "" {
+		return nil, fmt.Errorf("no Get%[1]s or Describe%[1]s operation found in AWS SDK for Go v2 service package %[2]s", noun, pkg.Name)
+	}
+
+	findInput, err := pkg.Input(g.td.FindOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	g.td.FindInputField = identifierField(findInput)
+	if g.td.FindInputField == "" {
+		return nil, fmt.Errorf("no required string identifier found in %s.%sInput", pkg.Name, g.td.FindOperation)
+	}
+
+	findOutput, err := pkg.Output(g.td.FindOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	var findResult *shape.Shape
+	if len(findOutput.Fields) == 1 && findOutput.Fields[0].Shape.Kind == shape.KindStruct && findOutput.Fields[0].Shape.Name != "" {
+		findResult = findOutput.Fields[0].Shape
+		g.td.FindResultField = findOutput.Fields[0].Name
+		g.td.FindResultType = "awstypes." + findResult.Name
+	} else {
+		findResult = findOutput
+		g.td.FindResultType = fmt.Sprintf("%s.%sOutput", pkg.Name, g.td.FindOperation)
+	}
+
+	// Resource identifier.
+	g.td.IDField = g.td.FindInputField
+	if _, ok := createOutput.Field(g.td.IDField); ok {
+		g.td.IDFromOutput = true
+	} else if _, ok := createInput.Field(g.td.IDField); !ok {
+		g.td.TODOs = append(g.td.TODOs, fmt.Sprintf("%s is in neither %[2]sInput nor %[2]sOutput, set the resource's ID in Create", g.td.IDField, createOperation))
+	}
+
+	// Updater.
+	var updateInput *shape.Shape
+	if v := "Update" + noun; pkg.HasOperation(v) {
+		g.td.UpdateOperation = v
+
+		updateInput, err = pkg.Input(v)
+		if err != nil {
+			return nil, err
+		}
+
+		g.td.UpdateInputField = identifierField(updateInput)
+	}
+
+	// Deleter.
+	if v := "Delete" + noun; pkg.HasOperation(v) {
+		g.td.DeleteOperation = v
+
+		deleteInput, err := pkg.Input(v)
+		if err != nil {
+			return nil, err
+		}
+
+		g.td.DeleteInputField = identifierField(deleteInput)
+	} else {
+		g.td.TODOs = append(g.td.TODOs, fmt.Sprintf("no Delete%s operation found, implement Delete", noun))
+	}
+
+	g.td.NotFoundException = "ResourceNotFoundException"
+	if types := pkg.Types(); !slices.Contains(types, g.td.NotFoundException) {
+		if i := slices.IndexFunc(types, func(v string) bool { return strings.HasSuffix(v, "NotFoundException") }); i != -1 {
+			g.td.NotFoundException = types[i]
+		} else {
+			g.td.TODOs = append(g.td.TODOs, "no NotFoundException found, check for the service's not found error")
+		}
+	}
+
+	// Status and waiters.
+	if v, ok := findResult.Field("Status"); ok && v.Shape.Kind == shape.KindEnum {
+		g.td.StatusField = v.Name
+		g.td.CreatePending = enumValues(v.Shape, "Creating", "Pending", "Provisioning", "InProgress", "Starting")
+		g.td.CreateTarget = enumValues(v.Shape, "Active", "Available", "Created", "Ready", "Enabled", "Running", "Succeeded", "Completed", "Healthy")
+		g.td.UpdatePending = enumValues(v.Shape, "Updating", "Modifying")
+		if deleting := enumValues(v.Shape, "Deleting"); len(deleting) > 0 {
+			g.td.DeletePending = append(deleting, g.td.CreateTarget...)
+		}
+
+		if len(g.td.CreateTarget) == 0 {
+			g.td.CreatePending = nil
+		}
+	}
+
+	// Schema and model.
+	var attributes, blocks []*member
+	seen := make(map[string]bool)
+	add := func(m *member) {
+		if m == nil || seen[m.tfName] {
+			return
+		}
+		seen[m.tfName] = true
+
+		if m.block {
+			blocks = append(blocks, m)
+		} else {
+			attributes = append(attributes, m)
+		}
+	}
+
+	add(&member{
+		tfName:    names.AttrID,
+		key:       "names.AttrID",
+		src:       "framework.IDAttribute()",
+		goName:    "ID",
+		modelType: "types.String",
+	})
+
+	for _, field := range createInput.Fields {
+		switch field.Name {
+		case "ClientToken":
+			g.td.ClientToken = field.Shape.Kind == shape.KindString
+			continue
+		case "DryRun":
+			continue
+		case "Tags":
+			if field.Shape.Kind == shape.KindMap {
+				g.td.IncludeTags = true
+				continue
+			}
+		}
+
+		m := mode{
+			required: field.Required,
+			optional: !field.Required,
+			replace:  true,
+		}
+		if updateInput != nil {
+			if _, ok := updateInput.Field(field.Name); ok {
+				m.replace = false
+				g.td.UpdateFields = append(g.td.UpdateFields, modelFieldName(field.Name))
+			}
+		}
+		if v, ok := findResult.Field(field.Name); ok && !field.Required && field.Shape.IsScalar() && v.Shape.IsScalar() {
+			m.computed = true
+		}
+
+		if strings.HasSuffix(field.Name, "Name") && field.Required && field.Shape.Kind == shape.KindString && g.td.NameField == "" {
+			g.td.NameField = modelFieldName(field.Name)
+		}
+
+		add(g.member(field, m))
+	}
+
+	var computed []*shape.Field
+	computed = append(computed, createOutput.Fields...)
+	if findResult != findOutput {
+		computed = append(computed, findResult.Fields...)
+	}
+	for _, field := range computed {
+		if field.Name == "Tags" {
+			if field.Shape.Kind == shape.KindMap && g.td.FindResultField != "" {
+				g.td.FindTags = g.td.IncludeTags
+			}
+			continue
+		}
+
+		// Skip descriptions of input fields prefixed with the resource's noun, e.g. "Name" for "AnalyzerName".
+		if _, ok := createInput.Field(noun + field.Name); ok {
+			continue
+		}
+
+		if !field.Shape.IsScalar() {
+			if !seen[convert.ToSnakeCase(field.Name, "")] {
+				g.td.TODOs = append(g.td.TODOs, fmt.Sprintf("computed attribute %s (%s) is not supported by skaff", field.Name, field.Shape.Kind))
+			}
+			continue
+		}
+
+		add(g.member(field, mode{computed: true}))
+	}
+
+	if g.td.IncludeTags {
+		add(&member{
+			tfName:    names.AttrTags,
+			key:       "names.AttrTags",
+			src:       "tftags.TagsAttribute()",
+			goName:    "Tags",
+			modelType: "types.Map",
+		})
+		add(&member{
+			tfName:    names.AttrTagsAll,
+			key:       "names.AttrTagsAll",
+			src:       "tftags.TagsAttributeComputedOnly()",
+			goName:    "TagsAll",
+			modelType: "types.Map",
+		})
+	}
+	if g.td.Timeouts() {
+		add(&member{
+			tfName:    names.AttrTimeouts,
+			key:       "names.AttrTimeouts",
+			src:       timeoutsBlock(g.td.CreateWaiter(), g.td.UpdateWaiter(), g.td.DeleteWaiter()),
+			goName:    "Timeouts",
+			modelType: "timeouts.Value",
+			block:     true,
+		})
+	}
+
+	slices.SortFunc(attributes, compareMembers)
+	slices.SortFunc(blocks, compareMembers)
+
+	for _, v := range attributes {
+		g.td.Attributes = append(g.td.Attributes, v.entry())
+	}
+	for _, v := range blocks {
+		g.td.Blocks = append(g.td.Blocks, v.entry())
+	}
+	g.td.ModelFields = modelFields(append(attributes, blocks...))
+
+	slices.Sort(g.td.UnionMembers)
+	g.td.UnionMembers = slices.Compact(g.td.UnionMembers)
+
+	return g.td, nil
+}
+
+// mode is how a schema attribute is configured.
+type mode struct {
+	required bool
+	optional bool
+	computed bool
+	replace  bool
+}
+
+// member is a generated schema attribute or block and its model field.
+type member struct {
+	tfName    string
+	key       string // Schema map key expression. Defaults to the quoted Terraform name.
+	src       string // Schema attribute or block expression.
+	block     bool
+	goName    string
+	modelType string
+	todo      string // Set for unsupported shapes, which generate only a comment.
+}
+
+func (m *member) entry() string {
+	if m.todo != "" {
+		return "// TODO: " + m.todo
+	}
+
+	key := m.key
+	if key == "" {
+		key = strconv.Quote(m.tfName)
+	}
+
+	return fmt.Sprintf("%s: %s,", key, m.src)
+}
+
+func compareMembers(a, b *member) int {
+	return strings.Compare(a.tfName, b.tfName)
+}
+
+// modelFields returns the model struct fields for the specified members.
+func modelFields(members []*member) []string {
+	members = slices.DeleteFunc(slices.Clone(members), func(v *member) bool {
+		return v.todo != ""
+	})
+	slices.SortFunc(members, func(a, b *member) int {
+		return strings.Compare(strings.ToLower(a.goName), strings.ToLower(b.goName))
+	})
+
+	var fields []string
+	for _, v := range members {
+		fields = append(fields, fmt.Sprintf("%s %s `tfsdk:%q`", v.goName, v.modelType, v.tfName))
+	}
+
+	return fields
+}
+
+// attributeSpec describes a schema attribute.
+type attributeSpec struct {
+	typeName           string // e.g. "String", "List".
+	customType         string
+	elementType        string
+	description        string
+	defaultValue       string
+	deprecationMessage string
+	sensitive          bool
+	validators         []string
+	todos              []string
+	mode
+}
+
+func (a attributeSpec) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "schema.%sAttribute{\n", a.typeName)
+	for _, v := range a.todos {
+		fmt.Fprintf(&b, "// TODO: %s.\n", v)
+	}
+	if a.customType != "" {
+		fmt.Fprintf(&b, "CustomType: %s,\n", a.customType)
+	}
+	if a.elementType != "" {
+		fmt.Fprintf(&b, "ElementType: %s,\n", a.elementType)
+	}
+	if a.description != "" {
+		fmt.Fprintf(&b, "Description: %s,\n", a.description)
+	}
+	if a.required {
+		b.WriteString("Required: true,\n")
+	}
+	if a.optional {
+		b.WriteString("Optional: true,\n")
+	}
+	if a.computed {
+		b.WriteString("Computed: true,\n")
+	}
+	if a.sensitive {
+		b.WriteString("Sensitive: true,\n")
+	}
+	if a.defaultValue != "" {
+		fmt.Fprintf(&b, "Default: %s,\n", a.defaultValue)
+	}
+	if a.deprecationMessage != "" {
+		fmt.Fprintf(&b, "DeprecationMessage: %s,\n", a.deprecationMessage)
+	}
+
+	var planModifiers []string
+	if a.replace {
+		planModifiers = append(planModifiers, strings.ToLower(a.typeName)+"planmodifier.RequiresReplace()")
+	}
+	// Defaults are planned for null values, so prior state is only needed for other computed values.
+	if a.computed && a.defaultValue == "" {
+		planModifiers = append(planModifiers, strings.ToLower(a.typeName)+"planmodifier.UseStateForUnknown()")
+	}
+	writeList(&b, "PlanModifiers", "planmodifier."+a.typeName, planModifiers)
+	writeList(&b, "Validators", "validator."+a.typeName, a.validators)
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+func writeList(b *strings.Builder, name, typ string, elems []string) {
+	if len(elems) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "%s: []%s{\n", name, typ)
+	for _, v := range elems {
+		fmt.Fprintf(b, "%s,\n", v)
+	}
+	b.WriteString("},\n")
+}
+
+func timeoutsBlock(create, update, del bool) string {
+	var b strings.Builder
+
+	b.WriteString("timeouts.Block(ctx, timeouts.Opts{\n")
+	for _, v := range []struct {
+		name    string
+		enabled bool
+	}{{"Create", create}, {"Update", update}, {"Delete", del}} {
+		if v.enabled {
+			fmt.Fprintf(&b, "%s: true,\n", v.name)
+		}
+	}
+	b.WriteString("})")
+
+	return b.String()
+}
+
+// member returns the schema attribute or block for the specified field.
+func (g *fromSDKGenerator) member(field *shape.Field, m mode) *member {
+	tfName := convert.ToSnakeCase(field.Name, "")
+	result := &member{
+		tfName: tfName,
+		key:    attrConsts[tfName],
+		goName: modelFieldName(field.Name),
+	}
+
+	if tfName == names.AttrARN && m.computed && !m.optional {
+		result.src = "framework.ARNAttributeComputedOnly()"
+		result.modelType = "types.String"
+		return result
+	}
+
+	spec := attributeSpec{mode: m}
+
+	switch s := field.Shape; s.Kind {
+	case shape.KindString, shape.KindBool, shape.KindInt64, shape.KindFloat64, shape.KindTimestamp, shape.KindEnum:
+		spec.typeName, spec.customType, result.modelType = scalarType(s)
+	case shape.KindList:
+		switch elem := s.Elem; elem.Kind {
+		case shape.KindString:
+			spec.typeName, spec.customType, spec.elementType = "List", "fwtypes.ListOfStringType", "types.StringType"
+			result.modelType = "fwtypes.ListValueOf[types.String]"
+		case shape.KindEnum:
+			spec.typeName, spec.customType, spec.elementType = "List", "fwtypes.ListOfStringType", "types.StringType"
+			spec.validators = []string{fmt.Sprintf("listvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.%s]())", elem.Name)}
+			result.modelType = "fwtypes.ListValueOf[types.String]"
+		case shape.KindBool, shape.KindInt64, shape.KindFloat64:
+			typeName, _, _ := scalarType(elem)
+			spec.typeName, spec.elementType = "List", "types."+typeName+"Type"
+			result.modelType = "types.List"
+		case shape.KindStruct, shape.KindUnion:
+			if m.computed && !m.optional && !m.required {
+				break
+			}
+			return g.block(field, elem, m, false)
+		}
+	case shape.KindMap:
+		if s.Elem.Kind == shape.KindString {
+			spec.typeName, spec.customType, spec.elementType = "Map", "fwtypes.MapOfStringType", "types.StringType"
+			result.modelType = "fwtypes.MapValueOf[types.String]"
+		}
+	case shape.KindStruct, shape.KindUnion:
+		if m.computed && !m.optional && !m.required {
+			break
+		}
+		return g.block(field, s, m, true)
+	}
+
+	if result.modelType == "" {
+		kind := field.Shape.Kind.String()
+		if field.Shape.Name != "" {
+			kind += " " + field.Shape.Name
+		}
+		if field.Shape.Elem != nil {
+			kind += " of " + field.Shape.Elem.Kind.String()
+		}
+		result.todo = fmt.Sprintf("%s (%s) is not supported by skaff.", field.Name, kind)
+		g.td.TODOs = append(g.td.TODOs, fmt.Sprintf("attribute %s (%s) is not supported by skaff", field.Name, kind))
+		return result
+	}
+
+	result.src = spec.String()
+
+	return result
+}
+
+// block returns the list nested block for the specified struct or union field.
+// Nested models are generated on first use.
+func (g *fromSDKGenerator) block(field *shape.Field, s *shape.Shape, m mode, single bool) *member {
+	modelName := convert.ToLowercasePrefix(s.Name) + "Data"
+	tfName := convert.ToSnakeCase(field.Name, "")
+
+	var validators []string
+	if single {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if m.required {
+		validators = append(validators, "listvalidator.IsRequired()")
+		if !single {
+			validators = append(validators, "listvalidator.SizeAtLeast(1)")
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString("schema.ListNestedBlock{\n")
+	fmt.Fprintf(&b, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
+	writeList(&b, "Validators", "validator.List", validators)
+	if m.replace {
+		writeList(&b, "PlanModifiers", "planmodifier.List", []string{"listplanmodifier.RequiresReplace()"})
+	}
+	b.WriteString("NestedObject: schema.NestedBlockObject{\n")
+
+	// Reserve the nested model's position so that parent models precede their children.
+	modelIndex := -1
+	if !g.models[modelName] {
+		g.models[modelName] = true
+		modelIndex = len(g.td.Models)
+		g.td.Models = append(g.td.Models, "")
+	}
+
+	var attributes, blocks, all []*member
+	for _, v := range s.Fields {
+		nestedMode := mode{
+			required: v.Required,
+			optional: !v.Required,
+		}
+		if s.Kind == shape.KindUnion {
+			g.td.UnionMembers = append(g.td.UnionMembers, fmt.Sprintf("awstypes.%sMember%s{}", s.Name, v.Name))
+		}
+
+		nested := g.member(v, nestedMode)
+		all = append(all, nested)
+		if nested.block {
+			blocks = append(blocks, nested)
+		} else {
+			attributes = append(attributes, nested)
+		}
+	}
+	slices.SortFunc(attributes, compareMembers)
+	slices.SortFunc(blocks, compareMembers)
+
+	if len(attributes) > 0 {
+		b.WriteString("Attributes: map[string]schema.Attribute{\n")
+		for _, v := range attributes {
+			b.WriteString(v.entry() + "\n")
+		}
+		b.WriteString("},\n")
+	}
+	if len(blocks) > 0 {
+		b.WriteString("Blocks: map[string]schema.Block{\n")
+		for _, v := range blocks {
+			b.WriteString(v.entry() + "\n")
+		}
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("},\n")
+	b.WriteString("}")
+
+	if modelIndex != -1 {
+		var model strings.Builder
+		fmt.Fprintf(&model, "type %s struct {\n", modelName)
+		for _, v := range modelFields(all) {
+			model.WriteString(v + "\n")
+		}
+		model.WriteString("}")
+
+		g.td.Models[modelIndex] = model.String()
+	}
+
+	return &member{
+		tfName:    tfName,
+		key:       attrConsts[tfName],
+		src:       b.String(),
+		block:     true,
+		goName:    modelFieldName(field.Name),
+		modelType: fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName),
+	}
+}
+
+// scalarType returns the schema attribute type name, custom type and model type for the specified scalar shape.
+func scalarType(s *shape.Shape) (string, string, string) {
+	switch s.Kind {
+	case shape.KindBool:
+		return "Bool", "", "types.Bool"
+	case shape.KindInt64:
+		return "Int64", "", "types.Int64"
+	case shape.KindFloat64:
+		return "Float64", "", "types.Float64"
+	case shape.KindTimestamp:
+		return "String", "timetypes.RFC3339Type{}", "timetypes.RFC3339"
+	case shape.KindEnum:
+		return "String", fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", s.Name), fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", s.Name)
+	default:
+		return "String", "", "types.String"
+	}
+}
+
+var attrConsts = map[string]string{
+	names.AttrARN:         "names.AttrARN",
+	names.AttrDescription: "names.AttrDescription",
+	names.AttrEnabled:     "names.AttrEnabled",
+	names.AttrKMSKeyARN:   "names.AttrKMSKeyARN",
+	names.AttrName:        "names.AttrName",
+	names.AttrType:        "names.AttrType",
+}
+
+var initialisms = map[string]string{
+	"Acl":   "ACL",
+	"Api":   "API",
+	"Arn":   "ARN",
+	"Arns":  "ARNs",
+	"Cidr":  "CIDR",
+	"Dns":   "DNS",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Iam":   "IAM",
+	"Id":    "ID",
+	"Ids":   "IDs",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Sns":   "SNS",
+	"Sql":   "SQL",
+	"Sqs":   "SQS",
+	"Ssl":   "SSL",
+	"Tls":   "TLS",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// modelFieldName returns the model struct field name for the specified AWS API field name.
+// Initialisms are capitalized, e.g. "KmsKeyArn" becomes "KMSKeyARN". AutoFlex matches field names case-insensitively.
+func modelFieldName(name string) string {
+	var b strings.Builder
+
+	for _, word := range words(name) {
+		if v, ok := initialisms[word]; ok {
+			word = v
+		}
+		b.WriteString(word)
+	}
+
+	return b.String()
+}
+
+// words splits a camel cased name into words, e.g. "VPCSubnetIds" into "VPC", "Subnet" and "Ids".
+func words(name string) []string {
+	var words []string
+
+	runes := []rune(name)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+
+		prev := runes[i-1]
+		if !unicode.IsUpper(prev) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
+
+// identifierField returns the name of the first required string field of the specified operation input.
+func identifierField(input *shape.Shape) string {
+	for _, v := range input.Fields {
+		if v.Required && v.Shape.Kind == shape.KindString {
+			return v.Name
+		}
+	}
+
+	return ""
+}
+
+// enumValues returns the Go constant names of the enum's values with any of the specified suffixes.
+func enumValues(s *shape.Shape, suffixes ...string) []string {
+	var values []string
+
+	for _, v := range s.Values {
+		value := strings.TrimPrefix(v, s.Name)
+		if slices.ContainsFunc(suffixes, func(suffix string) bool { return strings.EqualFold(value, suffix) }) {
+			values = append(values, "awstypes."+v)
+		}
+	}
+
+	return values
+}
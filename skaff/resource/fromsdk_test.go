@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/shape"
+)
+
+func testFromSDKTemplateData(t *testing.T) *FromSDKTemplateData {
+	t.Helper()
+
+	pkg, err := shape.Load("../shape/testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	td, err := newFromSDKTemplateData(pkg, "CreateWidget", TemplateData{
+		Resource:          "Widget",
+		ResourceSnake:     "widget",
+		ServicePackage:    "widgets",
+		Service:           "Widgets",
+		HumanResourceName: "Widget",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return td
+}
+
+func TestNewFromSDKTemplateData(t *testing.T) {
+	t.Parallel()
+
+	td := testFromSDKTemplateData(t)
+
+	if got, want := td.FinderName(), "findWidgetByID"; got != want {
+		t.Errorf("FinderName() = %s, want %s", got, want)
+	}
+	if got, want := td.FindResultType, "awstypes.Widget"; got != want {
+		t.Errorf("FindResultType = %s, want %s", got, want)
+	}
+	if !td.IDFromOutput || td.IDField != "WidgetId" {
+		t.Errorf("IDField = %s (output: %t), want WidgetId (output: true)", td.IDField, td.IDFromOutput)
+	}
+	if !td.ClientToken {
+		t.Error("ClientToken = false, want true")
+	}
+	if !td.IncludeTags || !td.FindTags {
+		t.Errorf("IncludeTags = %t, FindTags = %t, want true, true", td.IncludeTags, td.FindTags)
+	}
+	if got, want := td.NameField, "Name"; got != want {
+		t.Errorf("NameField = %s, want %s", got, want)
+	}
+	if got, want := td.UpdateFields, []string{"Configuration", "Description", "Rules"}; !slices.Equal(got, want) {
+		t.Errorf("UpdateFields = %v, want %v", got, want)
+	}
+	if got, want := td.UnionMembers, []string{"awstypes.FilterMemberPrefix{}", "awstypes.FilterMemberTag{}"}; !slices.Equal(got, want) {
+		t.Errorf("UnionMembers = %v, want %v", got, want)
+	}
+	if got, want := td.NotFoundException, "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundException = %s, want %s", got, want)
+	}
+
+	if got, want := td.CreatePending, []string{"awstypes.WidgetStatusCreating"}; !slices.Equal(got, want) {
+		t.Errorf("CreatePending = %v, want %v", got, want)
+	}
+	if got, want := td.CreateTarget, []string{"awstypes.WidgetStatusActive"}; !slices.Equal(got, want) {
+		t.Errorf("CreateTarget = %v, want %v", got, want)
+	}
+	if got, want := td.UpdatePending, []string{"awstypes.WidgetStatusUpdating"}; !slices.Equal(got, want) {
+		t.Errorf("UpdatePending = %v, want %v", got, want)
+	}
+	if got, want := td.DeletePending, []string{"awstypes.WidgetStatusDeleting", "awstypes.WidgetStatusActive"}; !slices.Equal(got, want) {
+		t.Errorf("DeletePending = %v, want %v", got, want)
+	}
+	if !td.Timeouts() {
+		t.Error("Timeouts() = false, want true")
+	}
+
+	// Document and recursive shapes are not supported.
+	if got, want := len(td.TODOs), 2; got != want {
+		t.Errorf("len(TODOs) = %d, want %d: %v", got, want, td.TODOs)
+	}
+}
+
+func TestExecuteFromSDKTemplate(t *testing.T) {
+	t.Parallel()
+
+	contents, err := executeFromSDKTemplate(testFromSDKTemplateData(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	src := string(contents)
+
+	for _, want := range []string{
+		`// @FrameworkResource("aws_widgets_widget", name="Widget")`,
+		`// @Tags(identifierAttribute="arn")`,
+		`names.AttrARN: framework.ARNAttributeComputedOnly(),`,
+		`CustomType: fwtypes.StringEnumType[awstypes.WidgetType](),`,
+		`listvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.Protocol]()),`,
+		`CustomType: fwtypes.NewListNestedObjectTypeOf[widgetConfigurationData](ctx),`,
+		`// TODO: Metadata (document) is not supported by skaff.`,
+		`flex.WithUnionMembers(awstypes.FilterMemberPrefix{}, awstypes.FilterMemberTag{})`,
+		`input.ClientToken = aws.String(sdkid.UniqueId())`,
+		`data.ID = flex.StringToFramework(ctx, output.WidgetId)`,
+		`func findWidgetByID(ctx context.Context, conn *widgets.Client, id string) (*awstypes.Widget, error) {`,
+		`Pending: enum.Slice(awstypes.WidgetStatusCreating),`,
+		`setTagsOut(ctx, out.Tags)`,
+		"SubnetIDs     fwtypes.ListValueOf[types.String]                        `tfsdk:\"subnet_ids\"`",
+		"Timeouts      timeouts.Value                                           `tfsdk:\"timeouts\"`",
+		"type filterData struct {",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated resource does not contain %q", want)
+		}
+	}
+
+	// Unused imports are removed.
+	for _, unwanted := range []string{"float64planmodifier", "mapplanmodifier"} {
+		if strings.Contains(src, unwanted) {
+			t.Errorf("generated resource contains %q", unwanted)
+		}
+	}
+}
+
+func TestModelFieldName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "Name", expected: "Name"},
+		{input: "WidgetId", expected: "WidgetID"},
+		{input: "SubnetIds", expected: "SubnetIDs"},
+		{input: "KmsKeyArn", expected: "KMSKeyARN"},
+		{input: "VPCEndpointId", expected: "VPCEndpointID"},
+		{input: "IdleTimeout", expected: "IdleTimeout"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := modelFieldName(testCase.input); got != testCase.expected {
+				t.Errorf("modelFieldName(%q) = %q, want %q", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
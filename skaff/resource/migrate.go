@@ -0,0 +1,1503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed resourcemigrate.tmpl
+var resourceMigrateTmpl string
+
+//go:embed resourcemigratetest.tmpl
+var resourceMigrateTestTmpl string
+
+// MigrateTemplateData is the data used to generate a Terraform Plugin Framework resource from a Terraform Plugin SDK V2 resource.
+type MigrateTemplateData struct {
+	TemplateData
+
+	SourceFile  string   // Plugin SDK V2 resource source file, e.g. "analyzer.go".
+	Annotations []string // Resource annotations other than @SDKResource, e.g. `@Tags(identifierAttribute="arn")`.
+	Imports     []string // Import specs, including those of packages referenced by expressions copied from the Plugin SDK V2 resource.
+
+	SchemaVersion      string
+	DeprecationMessage string
+	Attributes         []string // Top-level schema attributes.
+	Blocks             []string // Top-level schema blocks.
+	ModelFields        []string // Top-level model struct fields.
+	Models             []string // Nested model struct declarations.
+
+	// Default timeout expressions, e.g. "10 * time.Minute".
+	CreateTimeout string
+	ReadTimeout   string
+	UpdateTimeout string
+	DeleteTimeout string
+
+	ImportByID bool
+	Importer   string // Custom importer function, if any.
+
+	// Plugin SDK V2 CRUD handler functions.
+	CreateFunc string
+	ReadFunc   string
+	UpdateFunc string
+	DeleteFunc string
+
+	// State compatibility acceptance test.
+	ProviderVersion string // Last provider version with the Plugin SDK V2 resource.
+	ConfigFunc      string // e.g. "testAccAnalyzerConfig_basic".
+	ExistsFunc      string
+	ExistsType      string // Type of the exists function's result, if any.
+	DestroyFunc     string
+	PreCheck        bool
+	TestImports     []string
+
+	TODOs []string
+}
+
+func (td MigrateTemplateData) Timeouts() bool {
+	return td.CreateTimeout != "" || td.ReadTimeout != "" || td.UpdateTimeout != "" || td.DeleteTimeout != ""
+}
+
+// migrateImports are the import specs of all packages that a migrated resource may need.
+// Unused imports are removed once the resource has been generated.
+var migrateImports = []string{
+	`"context"`,
+	`"time"`,
+	`"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"`,
+	`"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`,
+	`"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`,
+	`"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"`,
+	`"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"`,
+	`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+	`"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"`,
+	`"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"`,
+	`"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"`,
+	`"github.com/hashicorp/terraform-plugin-framework/path"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"`,
+	`"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"`,
+	`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`,
+	`"github.com/hashicorp/terraform-plugin-framework/types"`,
+	`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+	`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`,
+	`fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"`,
+	`tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`,
+	`"github.com/hashicorp/terraform-provider-aws/names"`,
+}
+
+// migrateTestImports are the import specs of the packages that a state compatibility test needs.
+var migrateTestImports = []string{
+	`"testing"`,
+	`sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"`,
+	`"github.com/hashicorp/terraform-plugin-testing/helper/resource"`,
+	`"github.com/hashicorp/terraform-provider-aws/internal/acctest"`,
+	`"github.com/hashicorp/terraform-provider-aws/names"`,
+}
+
+// Migrate creates a Terraform Plugin Framework resource, and an acceptance test checking that it is state compatible,
+// from the Terraform Plugin SDK V2 resource in the specified file.
+// The resource name defaults to the Plugin SDK V2 resource's factory function name without its "resource" prefix.
+func Migrate(filename, resName, providerVersion string, comments, force bool) error {
+	if resName != "" && resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(filename))
+	if err != nil {
+		return fmt.Errorf("error reading directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(dir)
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting human-friendly name: %w", err)
+	}
+
+	namesDir, err := packageDir("github.com/hashicorp/terraform-provider-aws/names")
+	if err != nil {
+		return err
+	}
+
+	if providerVersion == "" {
+		providerVersion, err = lastReleasedVersion(filepath.Join(dir, "..", "..", "..", "CHANGELOG.md"))
+		if err != nil {
+			return fmt.Errorf("error getting last released provider version, set --provider-version: %w", err)
+		}
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		HumanFriendlyService: hf,
+		IncludeComments:      comments,
+		ServicePackage:       servicePackage,
+		Service:              s,
+		ServiceLower:         strings.ToLower(s),
+		AWSServiceName:       sn,
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+	}
+
+	td, err := newMigrateTemplateData(filename, namesDir, templateData)
+	if err != nil {
+		return err
+	}
+	td.ProviderVersion = providerVersion
+
+	base := strings.TrimSuffix(filename, ".go")
+	for _, v := range []struct {
+		filename, tmpl string
+	}{
+		{base + "_fw.go", resourceMigrateTmpl},
+		{base + "_migrate_test.go", resourceMigrateTestTmpl},
+	} {
+		if _, err := os.Stat(v.filename); !errors.Is(err, fs.ErrNotExist) && !force {
+			return fmt.Errorf("file (%s) already exists and force is not set", v.filename)
+		}
+
+		contents, err := executeMigrateTemplate(v.tmpl, td)
+		if err != nil {
+			return fmt.Errorf("generating %s: %w", v.filename, err)
+		}
+
+		if err := os.WriteFile(v.filename, contents, 0644); err != nil {
+			return fmt.Errorf("error writing to file (%s): %s", v.filename, err)
+		}
+	}
+
+	for _, v := range td.TODOs {
+		fmt.Fprintf(os.Stderr, "TODO: %s\n", v)
+	}
+
+	return nil
+}
+
+// executeMigrateTemplate executes the specified template, removes unused imports and formats the result.
+func executeMigrateTemplate(tmpl string, td *MigrateTemplateData) ([]byte, error) {
+	t, err := template.New("migrate").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := t.Execute(&buffer, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	src, err := removeUnusedImports(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generated file: %s", err)
+	}
+
+	contents, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	return contents, nil
+}
+
+// packageDir returns the directory containing the source code of the specified package,
+// as resolved by the Go module in the current working directory.
+func packageDir(importPath string) (string, error) {
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", importPath).Output()
+	if err != nil {
+		return "", fmt.Errorf("locating %s: %w", importPath, err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// lastReleasedVersion returns the most recent released version in the specified changelog.
+func lastReleasedVersion(changelog string) (string, error) {
+	b, err := os.ReadFile(changelog)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		if v, ok := strings.CutPrefix(line, "## "); ok && !strings.Contains(v, "Unreleased") {
+			version, _, _ := strings.Cut(v, " ")
+			return version, nil
+		}
+	}
+
+	return "", fmt.Errorf("no released version found in %s", changelog)
+}
+
+// customType is a Terraform Plugin Framework custom type that replaces Plugin SDK V2 validation or diff suppression.
+type customType struct {
+	schemaType string
+	modelType  string
+}
+
+var (
+	arnType             = &customType{"fwtypes.ARNType", "fwtypes.ARN"}
+	cidrBlockType       = &customType{"fwtypes.CIDRBlockType", "fwtypes.CIDRBlock"}
+	durationType        = &customType{"fwtypes.DurationType", "fwtypes.Duration"}
+	iamPolicyType       = &customType{"fwtypes.IAMPolicyType", "fwtypes.IAMPolicy"}
+	jsonType            = &customType{"jsontypes.NormalizedType{}", "jsontypes.Normalized"}
+	onceAWeekWindowType = &customType{"fwtypes.OnceAWeekWindowType", "fwtypes.OnceAWeekWindow"}
+	rfc3339Type         = &customType{"timetypes.RFC3339Type{}", "timetypes.RFC3339"}
+)
+
+func stringEnumType(t string) *customType {
+	return &customType{fmt.Sprintf("fwtypes.StringEnumType[%s]()", t), fmt.Sprintf("fwtypes.StringEnum[%s]", t)}
+}
+
+// validatorFuncs maps Plugin SDK V2 validation functions to Terraform Plugin Framework validators.
+// Any arguments are passed through.
+var validatorFuncs = map[string]struct {
+	typeName string
+	format   string
+}{
+	"validation.FloatAtLeast":            {"Float64", "float64validator.AtLeast(%s)"},
+	"validation.FloatAtMost":             {"Float64", "float64validator.AtMost(%s)"},
+	"validation.FloatBetween":            {"Float64", "float64validator.Between(%s)"},
+	"validation.IntAtLeast":              {"Int64", "int64validator.AtLeast(%s)"},
+	"validation.IntAtMost":               {"Int64", "int64validator.AtMost(%s)"},
+	"validation.IntBetween":              {"Int64", "int64validator.Between(%s)"},
+	"validation.IsIPv4Address":           {"String", "fwvalidators.IPv4Address()"},
+	"validation.IsIPv6Address":           {"String", "fwvalidators.IPv6Address()"},
+	"validation.StringIsJSON":            {"String", "fwvalidators.JSON()"},
+	"validation.StringIsNotEmpty":        {"String", "stringvalidator.LengthAtLeast(1)"},
+	"validation.StringLenBetween":        {"String", "stringvalidator.LengthBetween(%s)"},
+	"validation.StringMatch":             {"String", "stringvalidator.RegexMatches(%s)"},
+	"verify.ValidAccountID":              {"String", "fwvalidators.AWSAccountID()"},
+	"verify.ValidIPv4CIDRNetworkAddress": {"String", "fwvalidators.IPv4CIDRNetworkAddress()"},
+	"verify.ValidIPv6CIDRNetworkAddress": {"String", "fwvalidators.IPv6CIDRNetworkAddress()"},
+	"verify.ValidRegionName":             {"String", "fwvalidators.AWSRegion()"},
+}
+
+// customTypeValidateFuncs maps Plugin SDK V2 validation functions to the Terraform Plugin Framework custom types that validate values.
+var customTypeValidateFuncs = map[string]*customType{
+	"validation.IsCIDR":                 cidrBlockType,
+	"validation.IsRFC3339Time":          rfc3339Type,
+	"verify.ValidARN":                   arnType,
+	"verify.ValidCIDRNetworkAddress":    cidrBlockType,
+	"verify.ValidDuration":              durationType,
+	"verify.ValidIAMPolicyJSON":         iamPolicyType,
+	"verify.ValidOnceAWeekWindowFormat": onceAWeekWindowType,
+	"verify.ValidUTCTimestamp":          rfc3339Type,
+}
+
+// diffSuppressFuncs maps Plugin SDK V2 diff suppression functions to the Terraform Plugin Framework custom types
+// whose semantic equality replaces them. A nil value means that no replacement is needed.
+var diffSuppressFuncs = map[string]*customType{
+	"verify.SuppressEquivalentJSONDiffs":               jsonType,
+	"verify.SuppressEquivalentPolicyDiffs":             iamPolicyType,
+	"verify.SuppressMissingOptionalConfigurationBlock": nil,
+}
+
+// customTypeValidators are validators made redundant by custom types.
+var customTypeValidators = map[string][]*customType{
+	"fwvalidators.JSON()": {iamPolicyType, jsonType},
+}
+
+// conflictValidators maps Plugin SDK V2 schema fields to Terraform Plugin Framework path validator functions.
+var conflictValidators = []struct {
+	field    string
+	function string
+}{
+	{"AtLeastOneOf", "AtLeastOneOf"},
+	{"ConflictsWith", "ConflictsWith"},
+	{"ExactlyOneOf", "ExactlyOneOf"},
+	{"RequiredWith", "AlsoRequires"},
+}
+
+var (
+	sdkResourceAnnotation = regexache.MustCompile(`@SDKResource\("([^"]+)"(?:,\s*name="([^"]*)")?\)`)
+	awsTypesImportPath    = regexache.MustCompile(`^github\.com/aws/aws-sdk-go-v2/service/[^/]+/types$`)
+)
+
+// migrator converts a Plugin SDK V2 resource's schema to a Terraform Plugin Framework schema and models.
+type migrator struct {
+	td      *MigrateTemplateData
+	fset    *token.FileSet
+	imports map[string]string        // Import paths of the Plugin SDK V2 resource's file, keyed by package name.
+	consts  map[string]string        // String constant values, keyed by expression, e.g. "names.AttrName".
+	funcs   map[string]*ast.FuncDecl // The service package's functions.
+	vars    map[string]ast.Expr      // The service package's variable initializers, e.g. schema maps shared by several resources.
+	models  map[string]bool          // Names of generated nested models.
+}
+
+// newMigrateTemplateData returns the template data for migrating the Plugin SDK V2 resource in the specified file.
+// namesDir is the directory of the provider's names package, used to resolve attribute name constants.
+func newMigrateTemplateData(filename, namesDir string, td TemplateData) (*MigrateTemplateData, error) {
+	m := &migrator{
+		td: &MigrateTemplateData{
+			TemplateData: td,
+			SourceFile:   filepath.Base(filename),
+		},
+		fset:    token.NewFileSet(),
+		imports: make(map[string]string),
+		consts:  make(map[string]string),
+		funcs:   make(map[string]*ast.FuncDecl),
+		vars:    make(map[string]ast.Expr),
+		models:  make(map[string]bool),
+	}
+
+	file, err := parser.ParseFile(m.fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		m.imports[name] = path
+	}
+
+	if err := m.loadPackage(filepath.Dir(filename), ""); err != nil {
+		return nil, err
+	}
+	if err := m.loadPackage(namesDir, "names."); err != nil {
+		return nil, err
+	}
+
+	factory, typeName, humanName := m.factory(file)
+	if factory == nil {
+		return nil, fmt.Errorf("no Plugin SDK V2 resource found in %s", filename)
+	}
+
+	r := resourceLiteral(factory)
+	if r == nil {
+		return nil, fmt.Errorf("no schema.Resource literal found in %s", factory.Name.Name)
+	}
+
+	if m.td.Resource == "" {
+		m.td.Resource = strings.TrimPrefix(strings.TrimPrefix(factory.Name.Name, "resource"), "Resource")
+	}
+	if m.td.Resource == "" {
+		return nil, fmt.Errorf("error checking: no name given")
+	}
+	if typeName == "" {
+		typeName = convert.ToProviderResourceName(td.ServicePackage, convert.ToSnakeCase(m.td.Resource, ""))
+	}
+	if humanName == "" {
+		humanName = convert.ToHumanResName(m.td.Resource)
+	}
+
+	m.td.ResourceLower = strings.ToLower(m.td.Resource)
+	m.td.ResourceSnake = strings.TrimPrefix(typeName, fmt.Sprintf("aws_%s_", td.ServicePackage))
+	m.td.HumanResourceName = humanName
+	m.td.ProviderResourceName = typeName
+
+	if err := m.resource(r); err != nil {
+		return nil, err
+	}
+
+	m.acceptanceTest(filepath.Dir(filename))
+
+	m.td.Imports = groupImports(append(m.td.Imports, migrateImports...))
+	m.td.TestImports = groupImports(append(m.td.TestImports, migrateTestImports...))
+
+	return m.td, nil
+}
+
+// loadPackage records the functions and string constants declared in the specified directory's non-test Go files.
+// Constants are keyed by their name with the specified prefix.
+func (m *migrator) loadPackage(dir, prefix string) error {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(m.fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if prefix == "" && decl.Recv == nil {
+					m.funcs[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				if decl.Tok == token.VAR && prefix == "" {
+					for _, spec := range decl.Specs {
+						valueSpec := spec.(*ast.ValueSpec)
+						for i, name := range valueSpec.Names {
+							if i < len(valueSpec.Values) {
+								m.vars[name.Name] = valueSpec.Values[i]
+							}
+						}
+					}
+				}
+				if decl.Tok != token.CONST {
+					continue
+				}
+
+				for _, spec := range decl.Specs {
+					valueSpec := spec.(*ast.ValueSpec)
+					for i, name := range valueSpec.Names {
+						if i >= len(valueSpec.Values) {
+							continue
+						}
+						if v, ok := stringLiteral(valueSpec.Values[i]); ok {
+							m.consts[prefix+name.Name] = v
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// factory returns the file's Plugin SDK V2 resource factory function and its annotated resource type and name.
+func (m *migrator) factory(file *ast.File) (*ast.FuncDecl, string, string) {
+	var candidate *ast.FuncDecl
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil {
+			continue
+		}
+
+		if funcDecl.Doc != nil {
+			doc := funcDecl.Doc.Text()
+			if match := sdkResourceAnnotation.FindStringSubmatch(doc); match != nil {
+				for _, line := range strings.Split(doc, "\n") {
+					if strings.HasPrefix(line, "@") && !strings.HasPrefix(line, "@SDKResource") {
+						m.td.Annotations = append(m.td.Annotations, line)
+					}
+				}
+
+				return funcDecl, match[1], match[2]
+			}
+		}
+
+		if candidate == nil && funcDecl.Type.Results != nil && len(funcDecl.Type.Results.List) == 1 && m.source(funcDecl.Type.Results.List[0].Type) == "*schema.Resource" {
+			candidate = funcDecl
+		}
+	}
+
+	return candidate, "", ""
+}
+
+// resourceLiteral returns the outermost schema.Resource composite literal in the specified function.
+func resourceLiteral(funcDecl *ast.FuncDecl) *ast.CompositeLit {
+	var result *ast.CompositeLit
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+		if v, ok := n.(*ast.CompositeLit); ok && isType(v.Type, "schema", "Resource") {
+			result = v
+			return false
+		}
+		return true
+	})
+
+	return result
+}
+
+// resource migrates the schema.Resource literal's fields.
+func (m *migrator) resource(r *ast.CompositeLit) error {
+	fields := keyedFields(r)
+
+	for k, v := range map[string]*string{
+		"CreateWithoutTimeout": &m.td.CreateFunc,
+		"CreateContext":        &m.td.CreateFunc,
+		"ReadWithoutTimeout":   &m.td.ReadFunc,
+		"ReadContext":          &m.td.ReadFunc,
+		"UpdateWithoutTimeout": &m.td.UpdateFunc,
+		"UpdateContext":        &m.td.UpdateFunc,
+		"DeleteWithoutTimeout": &m.td.DeleteFunc,
+		"DeleteContext":        &m.td.DeleteFunc,
+	} {
+		if expr, ok := fields[k]; ok {
+			*v = m.source(expr)
+		}
+	}
+
+	if expr, ok := fields["Importer"]; ok {
+		if v := m.literal(expr); v != nil {
+			if stateContext, ok := keyedFields(v)["StateContext"]; ok {
+				if m.source(stateContext) == "schema.ImportStatePassthroughContext" {
+					m.td.ImportByID = true
+				} else {
+					m.td.Importer = m.source(stateContext)
+				}
+			}
+		}
+	}
+
+	if expr, ok := fields["Timeouts"]; ok {
+		if v := m.literal(expr); v != nil {
+			for _, elt := range v.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+
+				k, expr := m.source(kv.Key), kv.Value
+				call, ok := expr.(*ast.CallExpr)
+				if !ok || m.source(call.Fun) != "schema.DefaultTimeout" || len(call.Args) != 1 {
+					m.todo("timeout %s (%s) is not supported by skaff", k, m.source(expr))
+					continue
+				}
+
+				timeout := m.expr(call.Args[0])
+				switch k {
+				case "Create":
+					m.td.CreateTimeout = timeout
+				case "Read":
+					m.td.ReadTimeout = timeout
+				case "Update":
+					m.td.UpdateTimeout = timeout
+				case "Delete":
+					m.td.DeleteTimeout = timeout
+				}
+			}
+		}
+	}
+
+	if expr, ok := fields["SchemaVersion"]; ok {
+		m.td.SchemaVersion = m.source(expr)
+	}
+	if _, ok := fields["StateUpgraders"]; ok {
+		m.todo("migrate StateUpgraders to resource.ResourceWithUpgradeState")
+	}
+	if expr, ok := fields["DeprecationMessage"]; ok {
+		m.td.DeprecationMessage = m.expr(expr)
+	}
+	if expr, ok := fields["CustomizeDiff"]; ok && m.source(expr) != "verify.SetTagsDiff" {
+		m.todo("migrate CustomizeDiff (%s) to ModifyPlan", m.source(expr))
+	}
+
+	var schemaExpr ast.Expr
+	if v, ok := fields["Schema"]; ok {
+		schemaExpr = v
+	} else if v, ok := fields["SchemaFunc"]; ok {
+		schemaExpr = v
+	}
+	schemaMap := m.literal(schemaExpr)
+	if schemaMap == nil {
+		return errors.New("no schema found")
+	}
+
+	attributes, blocks := m.members(schemaMap, "")
+
+	if !slices.ContainsFunc(attributes, func(v *member) bool { return v.tfName == names.AttrID }) {
+		attributes = append(attributes, &member{
+			tfName:    names.AttrID,
+			key:       "names.AttrID",
+			src:       "framework.IDAttribute()",
+			goName:    "ID",
+			modelType: "types.String",
+		})
+	}
+	if m.td.Timeouts() {
+		blocks = append(blocks, &member{
+			tfName:    names.AttrTimeouts,
+			key:       "names.AttrTimeouts",
+			src:       m.timeoutsBlock(),
+			block:     true,
+			goName:    "Timeouts",
+			modelType: "timeouts.Value",
+		})
+	}
+
+	slices.SortFunc(attributes, compareMembers)
+	slices.SortFunc(blocks, compareMembers)
+
+	for _, v := range attributes {
+		m.td.Attributes = append(m.td.Attributes, v.entry())
+	}
+	for _, v := range blocks {
+		m.td.Blocks = append(m.td.Blocks, v.entry())
+	}
+	m.td.ModelFields = modelFields(append(attributes, blocks...))
+
+	return nil
+}
+
+func (m *migrator) timeoutsBlock() string {
+	var b strings.Builder
+
+	b.WriteString("timeouts.Block(ctx, timeouts.Opts{\n")
+	for _, v := range []struct {
+		name    string
+		timeout string
+	}{{"Create", m.td.CreateTimeout}, {"Read", m.td.ReadTimeout}, {"Update", m.td.UpdateTimeout}, {"Delete", m.td.DeleteTimeout}} {
+		if v.timeout != "" {
+			fmt.Fprintf(&b, "%s: true,\n", v.name)
+		}
+	}
+	b.WriteString("})")
+
+	return b.String()
+}
+
+// members returns the schema attributes and blocks for the specified Plugin SDK V2 schema map literal.
+// parent is the name of the enclosing block's model, if any.
+func (m *migrator) members(schemaMap *ast.CompositeLit, parent string) ([]*member, []*member) {
+	var attributes, blocks []*member
+
+	for _, elt := range schemaMap.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		result := m.member(kv.Key, kv.Value, parent)
+		if result.block {
+			blocks = append(blocks, result)
+		} else {
+			attributes = append(attributes, result)
+		}
+	}
+
+	return attributes, blocks
+}
+
+// member returns the schema attribute or block for the specified Plugin SDK V2 schema map entry.
+func (m *migrator) member(key, value ast.Expr, parent string) *member {
+	tfName, ok := m.stringValue(key)
+	if !ok {
+		tfName = convert.ToSnakeCase(strings.TrimPrefix(m.source(key), "names.Attr"), "")
+		m.todo("could not resolve attribute name %s, using %q", m.source(key), tfName)
+	}
+
+	result := &member{
+		tfName: tfName,
+		goName: goFieldName(tfName),
+	}
+	if _, ok := key.(*ast.BasicLit); !ok {
+		result.key = m.expr(key)
+	}
+
+	// Tags.
+	if call, ok := value.(*ast.CallExpr); ok {
+		switch m.source(call.Fun) {
+		case "tftags.TagsSchema", "tftags.TagsSchemaForceNew":
+			m.td.IncludeTags = true
+			result.src, result.modelType = "tftags.TagsAttribute()", "types.Map"
+			if m.source(call.Fun) == "tftags.TagsSchemaForceNew" {
+				m.todo("%s forced replacement, add a mapplanmodifier.RequiresReplace plan modifier", tfName)
+			}
+			return result
+		case "tftags.TagsSchemaComputed":
+			result.src, result.modelType = "tftags.TagsAttributeComputedOnly()", "types.Map"
+			return result
+		}
+	}
+
+	s := m.literal(value)
+	if s == nil {
+		return m.unsupported(result, "schema %s is not supported by skaff", m.source(value))
+	}
+
+	fields := keyedFields(s)
+	md := mode{
+		required: m.isTrue(fields["Required"]),
+		optional: m.isTrue(fields["Optional"]),
+		computed: m.isTrue(fields["Computed"]),
+		replace:  m.isTrue(fields["ForceNew"]),
+	}
+
+	if tfName == names.AttrARN && md.computed && !md.optional && !md.required {
+		result.src, result.modelType = "framework.ARNAttributeComputedOnly()", "types.String"
+		return result
+	}
+	if tfName == names.AttrID && md.computed && !md.optional && !md.required {
+		result.src, result.modelType = "framework.IDAttribute()", "types.String"
+		return result
+	}
+
+	todos := len(m.td.TODOs)
+	spec := attributeSpec{mode: md}
+	if v, ok := fields["Description"]; ok {
+		spec.description = m.expr(v)
+	}
+	if v, ok := fields["Deprecated"]; ok {
+		spec.deprecationMessage = m.expr(v)
+	}
+	spec.sensitive = m.isTrue(fields["Sensitive"])
+
+	switch typ := m.sdkType(fields["Type"]); typ {
+	case "TypeString", "TypeBool", "TypeInt", "TypeFloat":
+		spec.typeName = scalarTypeNames[typ]
+		custom, validators := m.scalar(tfName, spec.typeName, fields)
+		spec.validators = validators
+		result.modelType = "types." + spec.typeName
+		if custom != nil {
+			spec.customType, result.modelType = custom.schemaType, custom.modelType
+		}
+
+		if v, ok := fields["Default"]; ok {
+			spec.defaultValue = m.defaultValue(spec.typeName, custom, v)
+			spec.computed = true
+		}
+	case "TypeList", "TypeSet":
+		collection := "List"
+		if typ == "TypeSet" {
+			collection = "Set"
+		}
+
+		elem := m.literal(fields["Elem"])
+		if elem == nil {
+			return m.unsupported(result, "%s Elem %s is not supported by skaff", tfName, m.source(fields["Elem"]))
+		}
+
+		if isType(elem.Type, "schema", "Resource") {
+			return m.block(result, collection, md, fields, keyedFields(elem), spec, parent)
+		}
+
+		spec.typeName = collection
+		elemFields := keyedFields(elem)
+		elemType := scalarTypeNames[m.sdkType(elemFields["Type"])]
+		if elemType == "" {
+			return m.unsupported(result, "%s elements of %s are not supported by skaff", tfName, m.source(elemFields["Type"]))
+		}
+
+		custom, validators := m.scalar(tfName, elemType, elemFields)
+		switch {
+		case custom == arnType && collection == "List":
+			spec.customType, spec.elementType, result.modelType = "fwtypes.ListOfARNType", custom.schemaType, "fwtypes.ListValueOf[fwtypes.ARN]"
+		case custom != nil && strings.HasPrefix(custom.schemaType, "fwtypes.StringEnumType"):
+			validators = append(validators, strings.Replace(custom.schemaType, "fwtypes.StringEnumType", "enum.FrameworkValidate", 1))
+			m.addImport(`"github.com/hashicorp/terraform-provider-aws/internal/enum"`)
+			fallthrough
+		case custom == nil && elemType == "String":
+			spec.customType, spec.elementType = fmt.Sprintf("fwtypes.%sOfStringType", collection), "types.StringType"
+			result.modelType = fmt.Sprintf("fwtypes.%sValueOf[types.String]", collection)
+		case custom != nil && collection == "Set":
+			spec.customType, spec.elementType = fmt.Sprintf("fwtypes.NewSetTypeOf[%s](ctx)", custom.modelType), custom.schemaType
+			result.modelType = fmt.Sprintf("fwtypes.SetValueOf[%s]", custom.modelType)
+		case custom != nil:
+			spec.elementType, result.modelType = custom.schemaType, "types.List"
+		default:
+			spec.elementType, result.modelType = fmt.Sprintf("types.%sType", elemType), "types."+collection
+		}
+
+		if len(validators) > 0 {
+			spec.validators = append(spec.validators, fmt.Sprintf("%svalidator.Value%ssAre(%s)", strings.ToLower(collection), elemType, strings.Join(validators, ", ")))
+		}
+		spec.validators = append(spec.validators, m.sizeValidators(collection, fields)...)
+		spec.validators = append(spec.validators, m.pathValidators(collection, fields)...)
+	case "TypeMap":
+		spec.typeName = "Map"
+		elemType := "String"
+		var validators []string
+		if elem := m.literal(fields["Elem"]); elem != nil {
+			elemFields := keyedFields(elem)
+			elemType = scalarTypeNames[m.sdkType(elemFields["Type"])]
+			if elemType == "" {
+				return m.unsupported(result, "%s elements of %s are not supported by skaff", tfName, m.source(elemFields["Type"]))
+			}
+			var custom *customType
+			custom, validators = m.scalar(tfName, elemType, elemFields)
+			if custom != nil {
+				m.todo("%s element custom type %s is not supported by skaff", tfName, custom.schemaType)
+			}
+		}
+
+		if elemType == "String" {
+			spec.customType, spec.elementType, result.modelType = "fwtypes.MapOfStringType", "types.StringType", "fwtypes.MapValueOf[types.String]"
+		} else {
+			spec.elementType, result.modelType = fmt.Sprintf("types.%sType", elemType), "types.Map"
+		}
+
+		if len(validators) > 0 {
+			spec.validators = append(spec.validators, fmt.Sprintf("mapvalidator.Value%ssAre(%s)", elemType, strings.Join(validators, ", ")))
+		}
+		spec.validators = append(spec.validators, m.pathValidators("Map", fields)...)
+	default:
+		return m.unsupported(result, "%s Type %s is not supported by skaff", tfName, m.source(fields["Type"]))
+	}
+
+	// Anything that could not be migrated is also noted in the attribute's schema.
+	spec.todos = m.td.TODOs[todos:]
+	result.src = spec.String()
+
+	return result
+}
+
+var scalarTypeNames = map[string]string{
+	"TypeBool":   "Bool",
+	"TypeFloat":  "Float64",
+	"TypeInt":    "Int64",
+	"TypeString": "String",
+}
+
+// scalar returns the custom type and validators for the specified Plugin SDK V2 scalar schema.
+func (m *migrator) scalar(tfName, typeName string, fields map[string]ast.Expr) (*customType, []string) {
+	var custom *customType
+	var validators []string
+
+	for _, k := range []string{"ValidateFunc", "ValidateDiagFunc"} {
+		if v, ok := fields[k]; ok {
+			c, vs := m.validators(tfName, typeName, v)
+			validators = append(validators, vs...)
+			if c != nil {
+				custom = c
+			}
+		}
+	}
+
+	if v, ok := fields["DiffSuppressFunc"]; ok {
+		if c, ok := diffSuppressFuncs[m.source(v)]; !ok {
+			m.todo("%s DiffSuppressFunc %s is not supported by skaff", tfName, m.source(v))
+		} else if c != nil {
+			custom = c
+		}
+	}
+
+	// JSON and IAM policy normalization is replaced by the custom types' semantic equality.
+	if v, ok := fields["StateFunc"]; ok {
+		var b bytes.Buffer
+		printer.Fprint(&b, m.fset, v)
+
+		switch src := b.String(); {
+		case strings.Contains(src, "PolicyNormalize") || strings.Contains(src, "PolicyToSet"):
+			if custom == nil {
+				custom = iamPolicyType
+			}
+		case strings.Contains(src, "NormalizeJsonString"):
+			if custom == nil {
+				custom = jsonType
+			}
+		default:
+			m.todo("%s StateFunc %s is not supported by skaff", tfName, m.source(v))
+		}
+	}
+
+	if custom != nil {
+		validators = slices.DeleteFunc(validators, func(v string) bool {
+			return slices.Contains(customTypeValidators[v], custom)
+		})
+	}
+
+	validators = append(validators, m.pathValidators(typeName, fields)...)
+
+	return custom, validators
+}
+
+// validators returns the custom type and validators for the specified Plugin SDK V2 validation function.
+func (m *migrator) validators(tfName, typeName string, expr ast.Expr) (*customType, []string) {
+	fun, args := expr, []ast.Expr(nil)
+	if call, ok := expr.(*ast.CallExpr); ok {
+		fun, args = call.Fun, call.Args
+	}
+
+	var typeArg ast.Expr
+	if v, ok := fun.(*ast.IndexExpr); ok {
+		fun, typeArg = v.X, v.Index
+	}
+
+	name := m.source(fun)
+
+	switch name {
+	case "validation.ToDiagFunc":
+		if len(args) == 1 {
+			return m.validators(tfName, typeName, args[0])
+		}
+	case "validation.All":
+		var custom *customType
+		var validators []string
+		for _, arg := range args {
+			c, vs := m.validators(tfName, typeName, arg)
+			validators = append(validators, vs...)
+			if c != nil {
+				custom = c
+			}
+		}
+		return custom, validators
+	case "enum.Validate":
+		if typeArg != nil && typeName == "String" {
+			return stringEnumType(m.expr(typeArg)), nil
+		}
+	case "enum.ValidateIgnoreCase":
+		if typeArg != nil && typeName == "String" {
+			m.addImport(`"github.com/hashicorp/terraform-provider-aws/internal/enum"`)
+			return nil, []string{fmt.Sprintf("stringvalidator.OneOfCaseInsensitive(enum.Values[%s]()...)", m.expr(typeArg))}
+		}
+	case "validation.StringInSlice":
+		if len(args) == 2 && typeName == "String" {
+			ignoreCase := m.isTrue(args[1])
+			if call, ok := args[0].(*ast.CallExpr); ok && !ignoreCase {
+				if v, ok := call.Fun.(*ast.IndexExpr); ok && m.source(v.X) == "enum.Values" {
+					return stringEnumType(m.expr(v.Index)), nil
+				}
+			}
+
+			function := "OneOf"
+			if ignoreCase {
+				function = "OneOfCaseInsensitive"
+			}
+			return nil, []string{fmt.Sprintf("stringvalidator.%s(%s...)", function, m.expr(args[0]))}
+		}
+	case "validation.IntInSlice":
+		if v, ok := args[0].(*ast.CompositeLit); ok && len(args) == 1 && typeName == "Int64" {
+			var values []string
+			for _, elt := range v.Elts {
+				values = append(values, m.expr(elt))
+			}
+			return nil, []string{fmt.Sprintf("int64validator.OneOf(%s)", strings.Join(values, ", "))}
+		}
+	default:
+		if c, ok := customTypeValidateFuncs[name]; ok && typeName == "String" {
+			return c, nil
+		}
+
+		if v, ok := validatorFuncs[name]; ok && v.typeName == typeName {
+			if !strings.Contains(v.format, "%s") {
+				return nil, []string{v.format}
+			}
+
+			var values []string
+			for _, arg := range args {
+				values = append(values, m.expr(arg))
+			}
+			return nil, []string{fmt.Sprintf(v.format, strings.Join(values, ", "))}
+		}
+	}
+
+	m.todo("%s validation %s is not supported by skaff", tfName, m.source(expr))
+
+	return nil, nil
+}
+
+// defaultValue returns the schema attribute default for the specified Plugin SDK V2 default value.
+func (m *migrator) defaultValue(typeName string, custom *customType, expr ast.Expr) string {
+	value := m.expr(expr)
+
+	switch typeName {
+	case "Bool":
+		return fmt.Sprintf("booldefault.StaticBool(%s)", value)
+	case "Float64":
+		return fmt.Sprintf("float64default.StaticFloat64(%s)", value)
+	case "Int64":
+		return fmt.Sprintf("int64default.StaticInt64(%s)", value)
+	}
+
+	if custom != nil && strings.HasPrefix(custom.schemaType, "fwtypes.StringEnumType") {
+		return fmt.Sprintf("%s.AttributeDefault(%s)", custom.schemaType, value)
+	}
+
+	// Convert AWS SDK for Go v2 enum values.
+	if v, ok := expr.(*ast.SelectorExpr); ok {
+		if ident, ok := v.X.(*ast.Ident); ok && awsTypesImportPath.MatchString(m.imports[ident.Name]) {
+			value = fmt.Sprintf("string(%s)", value)
+		}
+	}
+
+	return fmt.Sprintf("stringdefault.StaticString(%s)", value)
+}
+
+// sizeValidators returns the collection size validators for the specified Plugin SDK V2 schema.
+func (m *migrator) sizeValidators(collection string, fields map[string]ast.Expr) []string {
+	var validators []string
+
+	prefix := strings.ToLower(collection) + "validator."
+	if v, ok := fields["MinItems"]; ok {
+		validators = append(validators, fmt.Sprintf("%sSizeAtLeast(%s)", prefix, m.expr(v)))
+	}
+	if v, ok := fields["MaxItems"]; ok {
+		validators = append(validators, fmt.Sprintf("%sSizeAtMost(%s)", prefix, m.expr(v)))
+	}
+
+	return validators
+}
+
+// pathValidators returns the path validators for the Plugin SDK V2 schema's ConflictsWith, ExactlyOneOf, AtLeastOneOf and RequiredWith fields.
+func (m *migrator) pathValidators(typeName string, fields map[string]ast.Expr) []string {
+	var validators []string
+
+	for _, v := range conflictValidators {
+		expr, ok := fields[v.field]
+		if !ok {
+			continue
+		}
+
+		list, ok := expr.(*ast.CompositeLit)
+		if !ok {
+			m.todo("%s %s is not supported by skaff", v.field, m.source(expr))
+			continue
+		}
+
+		var paths []string
+		for _, elt := range list.Elts {
+			s, ok := m.stringValue(elt)
+			if !ok {
+				m.todo("%s %s is not supported by skaff", v.field, m.source(elt))
+				continue
+			}
+			paths = append(paths, pathExpression(s))
+		}
+
+		if len(paths) > 0 {
+			validators = append(validators, fmt.Sprintf("%svalidator.%s(%s)", strings.ToLower(typeName), v.function, strings.Join(paths, ", ")))
+		}
+	}
+
+	return validators
+}
+
+// pathExpression returns the path expression for the specified Plugin SDK V2 attribute address, e.g. "a.0.b".
+func pathExpression(address string) string {
+	var b strings.Builder
+
+	for i, v := range strings.Split(address, ".") {
+		if i == 0 {
+			fmt.Fprintf(&b, "path.MatchRoot(%q)", v)
+		} else if _, err := strconv.Atoi(v); err == nil {
+			fmt.Fprintf(&b, ".AtListIndex(%s)", v)
+		} else {
+			fmt.Fprintf(&b, ".AtName(%q)", v)
+		}
+	}
+
+	return b.String()
+}
+
+// block returns the nested block, or computed nested attribute, for the specified Plugin SDK V2 list or set of resources.
+func (m *migrator) block(result *member, collection string, md mode, fields, elemFields map[string]ast.Expr, spec attributeSpec, parent string) *member {
+	modelName := convert.ToLowercasePrefix(goFieldName(result.tfName)) + "Data"
+	if m.models[modelName] {
+		modelName = parent + goFieldName(result.tfName) + "Data"
+	}
+	parentModel := strings.TrimSuffix(modelName, "Data")
+
+	result.modelType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", collection, modelName)
+
+	// Reserve the nested model's position so that parent models precede their children.
+	m.models[modelName] = true
+	modelIndex := len(m.td.Models)
+	m.td.Models = append(m.td.Models, "")
+
+	nested := m.literal(elemFields["Schema"])
+	var attributes, blocks []*member
+	if nested != nil {
+		attributes, blocks = m.members(nested, parentModel)
+	} else {
+		m.todo("%s nested schema %s is not supported by skaff", result.tfName, m.source(elemFields["Schema"]))
+	}
+
+	var model strings.Builder
+	fmt.Fprintf(&model, "type %s struct {\n", modelName)
+	for _, v := range modelFields(append(attributes, blocks...)) {
+		model.WriteString(v + "\n")
+	}
+	model.WriteString("}")
+	m.td.Models[modelIndex] = model.String()
+
+	// Blocks cannot be computed, so computed-only nested objects are attributes.
+	if md.computed && !md.optional && !md.required {
+		spec.typeName = collection
+		spec.customType = fmt.Sprintf("fwtypes.New%sNestedObjectTypeOf[%s](ctx)", collection, modelName)
+		spec.elementType = fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", modelName)
+		result.src = spec.String()
+		return result
+	}
+
+	if md.computed {
+		m.todo("%s was Optional and Computed, which blocks do not support", result.tfName)
+	}
+
+	var validators []string
+	if md.required {
+		validators = append(validators, strings.ToLower(collection)+"validator.IsRequired()")
+	}
+	validators = append(validators, m.sizeValidators(collection, fields)...)
+	validators = append(validators, m.pathValidators(collection, fields)...)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "schema.%sNestedBlock{\n", collection)
+	fmt.Fprintf(&b, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collection, modelName)
+	if spec.description != "" {
+		fmt.Fprintf(&b, "Description: %s,\n", spec.description)
+	}
+	if spec.deprecationMessage != "" {
+		fmt.Fprintf(&b, "DeprecationMessage: %s,\n", spec.deprecationMessage)
+	}
+	if md.replace {
+		writeList(&b, "PlanModifiers", "planmodifier."+collection, []string{strings.ToLower(collection) + "planmodifier.RequiresReplace()"})
+	}
+	writeList(&b, "Validators", "validator."+collection, validators)
+	b.WriteString("NestedObject: schema.NestedBlockObject{\n")
+
+	slices.SortFunc(attributes, compareMembers)
+	slices.SortFunc(blocks, compareMembers)
+
+	if len(attributes) > 0 {
+		b.WriteString("Attributes: map[string]schema.Attribute{\n")
+		for _, v := range attributes {
+			b.WriteString(v.entry() + "\n")
+		}
+		b.WriteString("},\n")
+	}
+	if len(blocks) > 0 {
+		b.WriteString("Blocks: map[string]schema.Block{\n")
+		for _, v := range blocks {
+			b.WriteString(v.entry() + "\n")
+		}
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("},\n")
+	b.WriteString("}")
+
+	result.src = b.String()
+	result.block = true
+
+	return result
+}
+
+func (m *migrator) unsupported(result *member, format string, a ...any) *member {
+	m.todo(format, a...)
+	result.todo = fmt.Sprintf(format, a...) + "."
+
+	return result
+}
+
+// acceptanceTest finds the existing acceptance test helpers used by the state compatibility test.
+func (m *migrator) acceptanceTest(dir string) {
+	res := m.td.Resource
+
+	m.td.ConfigFunc = fmt.Sprintf("testAcc%sConfig_basic", res)
+	m.td.DestroyFunc = fmt.Sprintf("testAccCheck%sDestroy", res)
+
+	funcs := make(map[string]*ast.FuncDecl)
+	fset := token.NewFileSet()
+
+	filenames, _ := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	for _, filename := range filenames {
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			if v, ok := decl.(*ast.FuncDecl); ok && v.Recv == nil {
+				funcs[v.Name.Name] = v
+			}
+		}
+
+		// The exists function's result type, e.g. "*types.AnalyzerSummary".
+		// Exists functions are usually declared in the resource's test file, but not always.
+		if v, ok := funcs[fmt.Sprintf("testAccCheck%sExists", res)]; ok && m.td.ExistsFunc == "" {
+			m.td.ExistsFunc = v.Name.Name
+
+			if params := v.Type.Params.List; len(params) > 0 {
+				if star, ok := params[len(params)-1].Type.(*ast.StarExpr); ok {
+					var b bytes.Buffer
+					printer.Fprint(&b, fset, star.X)
+					m.td.ExistsType = b.String()
+
+					if sel, ok := star.X.(*ast.SelectorExpr); ok {
+						if ident, ok := sel.X.(*ast.Ident); ok {
+							for _, spec := range file.Imports {
+								path, _ := strconv.Unquote(spec.Path.Value)
+								if (spec.Name != nil && spec.Name.Name == ident.Name) || (spec.Name == nil && filepath.Base(path) == ident.Name) {
+									m.td.TestImports = append(m.td.TestImports, importSpec(ident.Name, path))
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Fall back to the first configuration that takes only a name, e.g. "testAccAnalyzerConfig_name".
+	if _, ok := funcs[m.td.ConfigFunc]; !ok {
+		prefix := fmt.Sprintf("testAcc%sConfig_", res)
+		var configFuncs []string
+		for k, v := range funcs {
+			if strings.HasPrefix(k, prefix) && v.Type.Params.NumFields() == 1 {
+				configFuncs = append(configFuncs, k)
+			}
+		}
+		if len(configFuncs) > 0 {
+			m.td.ConfigFunc = slices.Min(configFuncs)
+		}
+	}
+
+	if _, ok := funcs[m.td.ConfigFunc]; !ok {
+		m.todo("no %s acceptance test configuration found", m.td.ConfigFunc)
+	} else if params := funcs[m.td.ConfigFunc].Type.Params.NumFields(); params != 1 {
+		m.todo("%s has %d parameters, update the state compatibility test's configuration", m.td.ConfigFunc, params)
+	}
+	if _, ok := funcs[m.td.DestroyFunc]; !ok {
+		m.todo("no %s acceptance test check found", m.td.DestroyFunc)
+	}
+	if m.td.ExistsFunc == "" {
+		m.todo("no testAccCheck%sExists acceptance test check found", res)
+	}
+	if v, ok := funcs["testAccPreCheck"]; ok && v.Type.Params.NumFields() == 2 {
+		m.td.PreCheck = true
+	}
+}
+
+// literal returns the composite literal that the specified expression evaluates to.
+// Address-of operators are removed, package variables are resolved to their initializer and calls to the package's functions and function literals are resolved to their returned composite literal.
+func (m *migrator) literal(expr ast.Expr) *ast.CompositeLit {
+	switch v := expr.(type) {
+	case *ast.CompositeLit:
+		return v
+	case *ast.UnaryExpr:
+		if v.Op == token.AND {
+			return m.literal(v.X)
+		}
+	case *ast.CallExpr:
+		if ident, ok := v.Fun.(*ast.Ident); ok && len(v.Args) == 0 {
+			if funcDecl, ok := m.funcs[ident.Name]; ok {
+				return m.returnedLiteral(funcDecl.Body)
+			}
+		}
+	case *ast.FuncLit:
+		return m.returnedLiteral(v.Body)
+	case *ast.Ident:
+		if v, ok := m.vars[v.Name]; ok {
+			return m.literal(v)
+		}
+	}
+
+	return nil
+}
+
+// returnedLiteral returns the composite literal returned by the specified function body's last statement.
+func (m *migrator) returnedLiteral(body *ast.BlockStmt) *ast.CompositeLit {
+	if body == nil || len(body.List) == 0 {
+		return nil
+	}
+
+	if v, ok := body.List[len(body.List)-1].(*ast.ReturnStmt); ok && len(v.Results) == 1 {
+		return m.literal(v.Results[0])
+	}
+
+	return nil
+}
+
+// keyedFields returns the specified composite literal's elements keyed by field name.
+func keyedFields(lit *ast.CompositeLit) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr)
+
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if ident, ok := kv.Key.(*ast.Ident); ok {
+				fields[ident.Name] = kv.Value
+			}
+		}
+	}
+
+	return fields
+}
+
+// isType returns whether the specified expression is the type `pkg.name`.
+func isType(expr ast.Expr, pkg, name string) bool {
+	if v, ok := expr.(*ast.SelectorExpr); ok {
+		if ident, ok := v.X.(*ast.Ident); ok {
+			return ident.Name == pkg && v.Sel.Name == name
+		}
+	}
+
+	return false
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	if v, ok := expr.(*ast.BasicLit); ok && v.Kind == token.STRING {
+		if s, err := strconv.Unquote(v.Value); err == nil {
+			return s, true
+		}
+	}
+
+	return "", false
+}
+
+// stringValue returns the value of the specified string literal or constant.
+func (m *migrator) stringValue(expr ast.Expr) (string, bool) {
+	if v, ok := stringLiteral(expr); ok {
+		return v, true
+	}
+
+	v, ok := m.consts[m.source(expr)]
+
+	return v, ok
+}
+
+func (m *migrator) isTrue(expr ast.Expr) bool {
+	return expr != nil && m.source(expr) == "true"
+}
+
+// sdkType returns the name of the specified Plugin SDK V2 value type, e.g. "TypeString".
+func (m *migrator) sdkType(expr ast.Expr) string {
+	if v, ok := expr.(*ast.SelectorExpr); ok {
+		return v.Sel.Name
+	}
+
+	return ""
+}
+
+// source returns the source code of the specified expression.
+func (m *migrator) source(expr ast.Expr) string {
+	if expr == nil {
+		return ""
+	}
+
+	// Function literals are too long to be useful in TODOs.
+	if _, ok := expr.(*ast.FuncLit); ok {
+		return "func literal"
+	}
+
+	var b bytes.Buffer
+	printer.Fprint(&b, m.fset, expr)
+
+	return b.String()
+}
+
+// expr returns the source code of the specified expression, which is copied to the generated resource.
+// Imports of referenced packages are added, with AWS SDK for Go v2 types packages imported as "awstypes".
+func (m *migrator) expr(expr ast.Expr) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		v, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		ident, ok := v.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		path, ok := m.imports[ident.Name]
+		if !ok {
+			return true
+		}
+
+		if awsTypesImportPath.MatchString(path) && ident.Name != "awstypes" {
+			ident.Name = "awstypes"
+			m.imports[ident.Name] = path
+		}
+		m.addImport(importSpec(ident.Name, path))
+
+		return true
+	})
+
+	return m.source(expr)
+}
+
+func (m *migrator) addImport(spec string) {
+	if !slices.Contains(m.td.Imports, spec) {
+		m.td.Imports = append(m.td.Imports, spec)
+	}
+}
+
+// groupImports returns the sorted and deduplicated import specs, with standard library packages first.
+// An empty spec separates standard library packages from the rest.
+func groupImports(specs []string) []string {
+	var std, other []string
+
+	for _, v := range specs {
+		path := v[strings.Index(v, `"`):]
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, v)
+		} else {
+			std = append(std, v)
+		}
+	}
+
+	byPath := func(a, b string) int {
+		return strings.Compare(a[strings.Index(a, `"`):], b[strings.Index(b, `"`):])
+	}
+	slices.SortFunc(std, byPath)
+	slices.SortFunc(other, byPath)
+
+	return append(append(slices.Compact(std), ""), slices.Compact(other)...)
+}
+
+func importSpec(name, path string) string {
+	if filepath.Base(path) == name {
+		return strconv.Quote(path)
+	}
+
+	return fmt.Sprintf("%s %q", name, path)
+}
+
+// todo records something that skaff could not translate.
+// TODOs are also written as single-line comments, so whitespace is collapsed.
+func (m *migrator) todo(format string, a ...any) {
+	m.td.TODOs = append(m.td.TODOs, strings.Join(strings.Fields(fmt.Sprintf(format, a...)), " "))
+}
+
+// goFieldName returns the model struct field name for the specified Terraform attribute name.
+// Initialisms are capitalized, e.g. "kms_key_arn" becomes "KMSKeyARN".
+func goFieldName(tfName string) string {
+	var b strings.Builder
+
+	for _, word := range strings.Split(tfName, "_") {
+		if word == "" {
+			continue
+		}
+
+		word = strings.ToUpper(word[:1]) + word[1:]
+		if v, ok := initialisms[word]; ok {
+			word = v
+		}
+		b.WriteString(word)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func testMigrateTemplateData(t *testing.T) *MigrateTemplateData {
+	t.Helper()
+
+	td, err := newMigrateTemplateData("testdata/widgets/widget.go", "../../names", TemplateData{
+		Resource:          "Widget",
+		ServicePackage:    "widgets",
+		Service:           "Widgets",
+		HumanResourceName: "Widget",
+		AWSGoSDKV2:        true,
+		PluginFramework:   true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	td.ProviderVersion = "5.46.0"
+
+	return td
+}
+
+func TestNewMigrateTemplateData(t *testing.T) {
+	t.Parallel()
+
+	td := testMigrateTemplateData(t)
+
+	if got, want := td.ProviderResourceName, "aws_widgets_widget"; got != want {
+		t.Errorf("ProviderResourceName = %s, want %s", got, want)
+	}
+	if got, want := td.Annotations, []string{`@Tags(identifierAttribute="arn")`}; !slices.Equal(got, want) {
+		t.Errorf("Annotations = %v, want %v", got, want)
+	}
+	if !td.IncludeTags {
+		t.Error("IncludeTags = false, want true")
+	}
+	if got, want := td.SchemaVersion, "1"; got != want {
+		t.Errorf("SchemaVersion = %s, want %s", got, want)
+	}
+	if got, want := td.CreateTimeout, "10 * time.Minute"; got != want {
+		t.Errorf("CreateTimeout = %s, want %s", got, want)
+	}
+	if got, want := td.DeleteTimeout, "5 * time.Minute"; got != want {
+		t.Errorf("DeleteTimeout = %s, want %s", got, want)
+	}
+	if td.ReadTimeout != "" || td.UpdateTimeout != "" {
+		t.Errorf("ReadTimeout = %q, UpdateTimeout = %q, want empty", td.ReadTimeout, td.UpdateTimeout)
+	}
+	if !td.ImportByID || td.Importer != "" {
+		t.Errorf("ImportByID = %t, Importer = %q, want true, empty", td.ImportByID, td.Importer)
+	}
+	if got, want := []string{td.CreateFunc, td.ReadFunc, td.UpdateFunc, td.DeleteFunc}, []string{"resourceWidgetCreate", "resourceWidgetRead", "resourceWidgetUpdate", "resourceWidgetDelete"}; !slices.Equal(got, want) {
+		t.Errorf("CRUD functions = %v, want %v", got, want)
+	}
+
+	if got, want := td.ConfigFunc, "testAccWidgetConfig_basic"; got != want {
+		t.Errorf("ConfigFunc = %s, want %s", got, want)
+	}
+	if got, want := td.ExistsFunc, "testAccCheckWidgetExists"; got != want {
+		t.Errorf("ExistsFunc = %s, want %s", got, want)
+	}
+	if got, want := td.ExistsType, "types.Widget"; got != want {
+		t.Errorf("ExistsType = %s, want %s", got, want)
+	}
+	if got, want := td.DestroyFunc, "testAccCheckWidgetDestroy"; got != want {
+		t.Errorf("DestroyFunc = %s, want %s", got, want)
+	}
+	if !td.PreCheck {
+		t.Error("PreCheck = false, want true")
+	}
+
+	// The custom token validator has no framework equivalent.
+	if got, want := len(td.TODOs), 1; got != want {
+		t.Errorf("len(TODOs) = %d, want %d: %v", got, want, td.TODOs)
+	}
+}
+
+func TestExecuteMigrateTemplate(t *testing.T) {
+	t.Parallel()
+
+	td := testMigrateTemplateData(t)
+
+	contents, err := executeMigrateTemplate(resourceMigrateTmpl, td)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	src := string(contents)
+
+	for _, want := range []string{
+		`// @FrameworkResource("aws_widgets_widget", name="Widget")`,
+		`// @Tags(identifierAttribute="arn")`,
+		`r.SetDefaultCreateTimeout(10 * time.Minute)`,
+		`framework.WithImportByID`,
+		`framework.WithTimeouts`,
+		`Version: 1,`,
+		`names.AttrARN: framework.ARNAttributeComputedOnly(),`,
+		`names.AttrID: framework.IDAttribute(),`,
+		`CustomType: fwtypes.IAMPolicyType,`,
+		`CustomType: fwtypes.ARNType,`,
+		`CustomType: fwtypes.StringEnumType[awstypes.WidgetMode](),`,
+		`Default:    fwtypes.StringEnumType[awstypes.WidgetMode]().AttributeDefault(awstypes.WidgetModeFast),`,
+		`Default:  int64default.StaticInt64(10),`,
+		`int64validator.Between(1, 100),`,
+		`stringvalidator.LengthBetween(1, 64),`,
+		`stringvalidator.ConflictsWith(path.MatchRoot("legacy_description")),`,
+		`setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.Protocol]()),`,
+		`setvalidator.SizeAtLeast(1),`,
+		`listvalidator.SizeAtMost(1),`,
+		`DeprecationMessage: "Use description instead",`,
+		`Sensitive: true,`,
+		`// TODO: token validation validateToken is not supported by skaff.`,
+		`CustomType: fwtypes.NewListNestedObjectTypeOf[configurationData](ctx),`,
+		`ElementType: fwtypes.NewObjectTypeOf[endpointsData](ctx),`,
+		`// TODO: Port resourceWidgetCreate.`,
+		`r.SetTagsAll(ctx, req, resp)`,
+		`names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{`,
+		"Policy            fwtypes.IAMPolicy",
+		"type ruleData struct {",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated resource does not contain %q", want)
+		}
+	}
+
+	// Unused imports are removed.
+	for _, unwanted := range []string{"float64validator", "mapplanmodifier", "jsontypes"} {
+		if strings.Contains(src, unwanted) {
+			t.Errorf("generated resource contains %q", unwanted)
+		}
+	}
+
+	contents, err = executeMigrateTemplate(resourceMigrateTestTmpl, td)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	src = string(contents)
+
+	for _, want := range []string{
+		"package widgets_test",
+		`"github.com/aws/aws-sdk-go-v2/service/widgets/types"`,
+		"func TestAccWidgetsWidget_MigrateFromPluginSDK(t *testing.T) {",
+		"var v types.Widget",
+		`VersionConstraint: "5.46.0",`,
+		"testAccCheckWidgetExists(ctx, resourceName, &v),",
+		"ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,",
+		"PlanOnly:                 true,",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated test does not contain %q", want)
+		}
+	}
+}
+
+func TestPathExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "name", expected: `path.MatchRoot("name")`},
+		{input: "configuration.0.max_size", expected: `path.MatchRoot("configuration").AtListIndex(0).AtName("max_size")`},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := pathExpression(testCase.input); got != testCase.expected {
+				t.Errorf("pathExpression(%q) = %q, want %q", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestGoFieldName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "name", expected: "Name"},
+		{input: "role_arn", expected: "RoleARN"},
+		{input: "subnet_ids", expected: "SubnetIDs"},
+		{input: "kms_key_id", expected: "KMSKeyID"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := goFieldName(testCase.input); got != testCase.expected {
+				t.Errorf("goFieldName(%q) = %q, want %q", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestLastReleasedVersion(t *testing.T) {
+	t.Parallel()
+
+	changelog := filepath.Join(t.TempDir(), "CHANGELOG.md")
+	if err := os.WriteFile(changelog, []byte("## 5.47.0 (Unreleased)\n\nFEATURES:\n\n## 5.46.0 (April 18, 2024)\n"), 0644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := lastReleasedVersion(changelog)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := "5.46.0"; got != want {
+		t.Errorf("lastReleasedVersion() = %s, want %s", got, want)
+	}
+}
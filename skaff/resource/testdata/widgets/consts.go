@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widgets
+
+const (
+	attrLegacyDescription = "legacy_description"
+)
+
+func validateToken(v interface{}, k string) (ws []string, errors []error) {
+	return nil, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widgets_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/widgets/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {}
+
+func testAccCheckWidgetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return nil
+}
+
+func testAccCheckWidgetExists(ctx context.Context, n string, v *types.Widget) resource.TestCheckFunc {
+	return nil
+}
+
+func testAccWidgetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_widgets_widget" "test" {
+  name = %[1]q
+}
+`, rName)
+}
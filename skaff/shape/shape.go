@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package shape reads the shapes of AWS SDK for Go v2 API operation inputs and outputs
+// from the SDK's generated source code.
+package shape
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Kind is the kind of a shape.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindBool
+	KindInt64
+	KindFloat64
+	KindTimestamp
+	KindEnum
+	KindStruct
+	KindList
+	KindMap
+	KindDocument
+	KindUnion
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindBool:
+		return "bool"
+	case KindInt64:
+		return "int64"
+	case KindFloat64:
+		return "float64"
+	case KindTimestamp:
+		return "timestamp"
+	case KindEnum:
+		return "enum"
+	case KindStruct:
+		return "struct"
+	case KindList:
+		return "list"
+	case KindMap:
+		return "map"
+	case KindDocument:
+		return "document"
+	case KindUnion:
+		return "union"
+	default:
+		return "unsupported"
+	}
+}
+
+// Shape describes the type of an API operation input or output, or one of their fields.
+type Shape struct {
+	Kind Kind
+	// Name is the Go type name of enum, struct and union shapes in the SDK's types package, e.g. "EncryptionType".
+	Name string
+	// Elem is the element shape of list and map shapes.
+	Elem *Shape
+	// Fields are the fields of struct shapes and the members of union shapes.
+	Fields []*Field
+	// Values are the Go constant names of enum shapes' values, e.g. "EncryptionTypeKms".
+	Values []string
+}
+
+// IsScalar returns whether the shape maps to a single Terraform attribute value.
+func (s *Shape) IsScalar() bool {
+	switch s.Kind {
+	case KindString, KindBool, KindInt64, KindFloat64, KindTimestamp, KindEnum:
+		return true
+	default:
+		return false
+	}
+}
+
+// Field returns the struct shape's field with the specified name.
+func (s *Shape) Field(name string) (*Field, bool) {
+	for _, v := range s.Fields {
+		if v.Name == name {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// Field is a field of a struct shape.
+type Field struct {
+	Name     string
+	Required bool
+	Shape    *Shape
+}
+
+const (
+	requiredMarker            = "This member is required."
+	unionMemberValueFieldName = "Value"
+)
+
+// Package is an AWS SDK for Go v2 service package.
+type Package struct {
+	Name string // e.g. "accessanalyzer"
+
+	enumValues map[string][]string
+	operations map[string]*ast.StructType // Operation input and output structs, keyed by type name.
+	shapes     map[string]*Shape          // Resolved types package shapes.
+	types      map[string]ast.Expr        // Types package type declarations.
+}
+
+// Dir returns the directory containing the source code of the specified AWS SDK for Go v2 service package,
+// e.g. "accessanalyzer", as resolved by the Go module in the current working directory.
+func Dir(service string) (string, error) {
+	importPath := "github.com/aws/aws-sdk-go-v2/service/" + service
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", importPath).Output()
+	if err != nil {
+		return "", fmt.Errorf("locating %s: %w", importPath, err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Load parses the AWS SDK for Go v2 service package source code in the specified directory.
+func Load(dir string) (*Package, error) {
+	// Module cache directories are suffixed with the module version, e.g. "accessanalyzer@v1.29.1".
+	name, _, _ := strings.Cut(filepath.Base(dir), "@")
+
+	p := &Package{
+		Name:       name,
+		enumValues: make(map[string][]string),
+		operations: make(map[string]*ast.StructType),
+		shapes:     make(map[string]*Shape),
+		types:      make(map[string]ast.Expr),
+	}
+
+	if err := p.parse(dir, "api_op_*.go", p.addOperationDecl); err != nil {
+		return nil, err
+	}
+
+	if len(p.operations) == 0 {
+		return nil, fmt.Errorf("no API operations found in %s", dir)
+	}
+
+	if err := p.parse(filepath.Join(dir, "types"), "*.go", p.addTypeDecl); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *Package) parse(dir, pattern string, f func(ast.Decl)) error {
+	filenames, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+
+		file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			f(decl)
+		}
+	}
+
+	return nil
+}
+
+func (p *Package) addOperationDecl(decl ast.Decl) {
+	genDecl, ok := decl.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.TYPE {
+		return
+	}
+
+	for _, spec := range genDecl.Specs {
+		typeSpec := spec.(*ast.TypeSpec)
+		name := typeSpec.Name.Name
+
+		if v, ok := typeSpec.Type.(*ast.StructType); ok && (strings.HasSuffix(name, "Input") || strings.HasSuffix(name, "Output")) {
+			p.operations[name] = v
+		}
+	}
+}
+
+func (p *Package) addTypeDecl(decl ast.Decl) {
+	genDecl, ok := decl.(*ast.GenDecl)
+	if !ok {
+		return
+	}
+
+	switch genDecl.Tok {
+	case token.TYPE:
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			p.types[typeSpec.Name.Name] = typeSpec.Type
+		}
+	case token.CONST:
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if ident, ok := valueSpec.Type.(*ast.Ident); ok {
+				for _, name := range valueSpec.Names {
+					p.enumValues[ident.Name] = append(p.enumValues[ident.Name], name.Name)
+				}
+			}
+		}
+	}
+}
+
+// HasOperation returns whether the package has the specified API operation, e.g. "CreateAnalyzer".
+func (p *Package) HasOperation(operation string) bool {
+	_, ok := p.operations[operation+"Input"]
+	return ok
+}
+
+// Types returns the sorted names of the package's types package types.
+func (p *Package) Types() []string {
+	names := make([]string, 0, len(p.types))
+	for k := range p.types {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Input returns the shape of the specified API operation's input.
+func (p *Package) Input(operation string) (*Shape, error) {
+	return p.operationShape(operation + "Input")
+}
+
+// Output returns the shape of the specified API operation's output.
+// The `ResultMetadata` field is omitted.
+func (p *Package) Output(operation string) (*Shape, error) {
+	shape, err := p.operationShape(operation + "Output")
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []*Field
+	for _, v := range shape.Fields {
+		if v.Name != "ResultMetadata" {
+			fields = append(fields, v)
+		}
+	}
+	shape.Fields = fields
+
+	return shape, nil
+}
+
+func (p *Package) operationShape(name string) (*Shape, error) {
+	v, ok := p.operations[name]
+	if !ok {
+		return nil, fmt.Errorf("%s.%s not found", p.Name, name)
+	}
+
+	return p.structShape(v, false, nil), nil
+}
+
+// structShape returns the shape of the specified struct type.
+// Type names without a package qualifier are resolved in the types package if local is set.
+func (p *Package) structShape(v *ast.StructType, local bool, stack []string) *Shape {
+	shape := &Shape{
+		Kind: KindStruct,
+	}
+
+	for _, field := range v.Fields.List {
+		// Skip embedded fields, e.g. noSmithyDocumentSerde.
+		if len(field.Names) == 0 {
+			continue
+		}
+
+		required := field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMarker)
+		fieldShape := p.exprShape(field.Type, local, stack)
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			shape.Fields = append(shape.Fields, &Field{
+				Name:     name.Name,
+				Required: required,
+				Shape:    fieldShape,
+			})
+		}
+	}
+
+	return shape
+}
+
+func (p *Package) exprShape(expr ast.Expr, local bool, stack []string) *Shape {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return p.exprShape(v.X, local, stack)
+	case *ast.Ident:
+		switch v.Name {
+		case "string":
+			return &Shape{Kind: KindString}
+		case "bool":
+			return &Shape{Kind: KindBool}
+		case "int", "int8", "int16", "int32", "int64":
+			return &Shape{Kind: KindInt64}
+		case "float32", "float64":
+			return &Shape{Kind: KindFloat64}
+		}
+
+		if local {
+			return p.typeShape(v.Name, stack)
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := v.X.(*ast.Ident); ok {
+			switch pkg.Name + "." + v.Sel.Name {
+			case "time.Time":
+				return &Shape{Kind: KindTimestamp}
+			case "document.Interface":
+				return &Shape{Kind: KindDocument}
+			}
+
+			if pkg.Name == "types" {
+				return p.typeShape(v.Sel.Name, stack)
+			}
+		}
+	case *ast.ArrayType:
+		// Blobs are not supported.
+		if ident, ok := v.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			break
+		}
+
+		return &Shape{
+			Kind: KindList,
+			Elem: p.exprShape(v.Elt, local, stack),
+		}
+	case *ast.MapType:
+		if ident, ok := v.Key.(*ast.Ident); ok && ident.Name == "string" {
+			return &Shape{
+				Kind: KindMap,
+				Elem: p.exprShape(v.Value, local, stack),
+			}
+		}
+	}
+
+	return &Shape{Kind: KindUnsupported}
+}
+
+// typeShape returns the shape of the specified types package type.
+// Recursive types are unsupported.
+func (p *Package) typeShape(name string, stack []string) *Shape {
+	if v, ok := p.shapes[name]; ok {
+		return v
+	}
+
+	for _, v := range stack {
+		if v == name {
+			return &Shape{Kind: KindUnsupported, Name: name}
+		}
+	}
+
+	var shape *Shape
+
+	switch v := p.types[name].(type) {
+	case *ast.Ident:
+		if v.Name == "string" {
+			shape = &Shape{
+				Kind:   KindEnum,
+				Name:   name,
+				Values: p.enumValues[name],
+			}
+		}
+	case *ast.StructType:
+		shape = p.structShape(v, true, append(stack, name))
+		shape.Name = name
+	case *ast.InterfaceType:
+		shape = p.unionShape(name, append(stack, name))
+	}
+
+	if shape == nil {
+		shape = &Shape{Kind: KindUnsupported, Name: name}
+	}
+
+	p.shapes[name] = shape
+
+	return shape
+}
+
+// unionShape returns the shape of the specified types package union (interface) type.
+// Union member types are named `<Union>Member<Name>` and hold the member's value in their `Value` field.
+func (p *Package) unionShape(name string, stack []string) *Shape {
+	shape := &Shape{
+		Kind: KindUnion,
+		Name: name,
+	}
+
+	prefix := name + "Member"
+	var members []string
+	for k := range p.types {
+		if strings.HasPrefix(k, prefix) && len(k) > len(prefix) {
+			members = append(members, k)
+		}
+	}
+	slices.Sort(members)
+
+	for _, member := range members {
+		v, ok := p.types[member].(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		for _, field := range v.Fields.List {
+			if len(field.Names) == 1 && field.Names[0].Name == unionMemberValueFieldName {
+				shape.Fields = append(shape.Fields, &Field{
+					Name:  strings.TrimPrefix(member, prefix),
+					Shape: p.exprShape(field.Type, true, stack),
+				})
+			}
+		}
+	}
+
+	return shape
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shape
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	p, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := p.Name, "widgets"; got != want {
+		t.Errorf("Name = %s, want %s", got, want)
+	}
+
+	for _, v := range []string{"CreateWidget", "GetWidget", "UpdateWidget", "DeleteWidget"} {
+		if !p.HasOperation(v) {
+			t.Errorf("HasOperation(%s) = false, want true", v)
+		}
+	}
+
+	if p.HasOperation("ListWidgets") {
+		t.Error("HasOperation(ListWidgets) = true, want false")
+	}
+
+	if _, err := Load("testdata/widgets/types"); err == nil {
+		t.Error("expected error loading directory without operations")
+	}
+}
+
+func TestPackageInput(t *testing.T) {
+	t.Parallel()
+
+	p, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	input, err := p.Input("CreateWidget")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name     string
+		kind     Kind
+		typeName string
+		required bool
+		elemKind Kind
+	}{
+		{name: "Name", kind: KindString, required: true},
+		{name: "Type", kind: KindEnum, typeName: "WidgetType", required: true},
+		{name: "ClientToken", kind: KindString},
+		{name: "Configuration", kind: KindStruct, typeName: "WidgetConfiguration"},
+		{name: "Description", kind: KindString},
+		{name: "Enabled", kind: KindBool},
+		{name: "Filter", kind: KindUnion, typeName: "Filter"},
+		{name: "Metadata", kind: KindDocument},
+		{name: "Protocols", kind: KindList, elemKind: KindEnum},
+		{name: "Rules", kind: KindList, elemKind: KindStruct},
+		{name: "Size", kind: KindInt64},
+		{name: "SubnetIds", kind: KindList, elemKind: KindString},
+		{name: "Tags", kind: KindMap, elemKind: KindString},
+	}
+
+	if got, want := len(input.Fields), len(testCases); got != want {
+		t.Fatalf("len(Fields) = %d, want %d", got, want)
+	}
+
+	for i, testCase := range testCases {
+		field := input.Fields[i]
+
+		if got, want := field.Name, testCase.name; got != want {
+			t.Errorf("Fields[%d].Name = %s, want %s", i, got, want)
+			continue
+		}
+		if got, want := field.Shape.Kind, testCase.kind; got != want {
+			t.Errorf("%s Kind = %s, want %s", field.Name, got, want)
+		}
+		if got, want := field.Shape.Name, testCase.typeName; got != want {
+			t.Errorf("%s Name = %s, want %s", field.Name, got, want)
+		}
+		if got, want := field.Required, testCase.required; got != want {
+			t.Errorf("%s Required = %t, want %t", field.Name, got, want)
+		}
+		if testCase.elemKind != KindUnsupported {
+			if got, want := field.Shape.Elem.Kind, testCase.elemKind; got != want {
+				t.Errorf("%s Elem.Kind = %s, want %s", field.Name, got, want)
+			}
+		}
+	}
+
+	// Enum values.
+	field, _ := input.Field("Type")
+	if got, want := field.Shape.Values, []string{"WidgetTypeSprocket", "WidgetTypeGear"}; !slices.Equal(got, want) {
+		t.Errorf("Type Values = %v, want %v", got, want)
+	}
+
+	// Nested struct, including a recursive reference.
+	field, _ = input.Field("Configuration")
+	var names []string
+	for _, v := range field.Shape.Fields {
+		names = append(names, v.Name)
+	}
+	if got, want := names, []string{"MaxSize", "Mode", "Parent"}; !slices.Equal(got, want) {
+		t.Errorf("Configuration Fields = %v, want %v", got, want)
+	}
+	if v, _ := field.Shape.Field("MaxSize"); !v.Required {
+		t.Error("Configuration.MaxSize Required = false, want true")
+	}
+	if v, _ := field.Shape.Field("Mode"); v.Shape.Kind != KindEnum {
+		t.Errorf("Configuration.Mode Kind = %s, want %s", v.Shape.Kind, KindEnum)
+	}
+	if v, _ := field.Shape.Field("Parent"); v.Shape.Kind != KindUnsupported {
+		t.Errorf("Configuration.Parent Kind = %s, want %s", v.Shape.Kind, KindUnsupported)
+	}
+
+	// Union members.
+	field, _ = input.Field("Filter")
+	if got, want := len(field.Shape.Fields), 2; got != want {
+		t.Fatalf("len(Filter Fields) = %d, want %d", got, want)
+	}
+	if v := field.Shape.Fields[0]; v.Name != "Prefix" || v.Shape.Kind != KindString {
+		t.Errorf("Filter Fields[0] = %s (%s), want Prefix (%s)", v.Name, v.Shape.Kind, KindString)
+	}
+	if v := field.Shape.Fields[1]; v.Name != "Tag" || v.Shape.Kind != KindStruct || v.Shape.Name != "TagFilter" {
+		t.Errorf("Filter Fields[1] = %s (%s %s), want Tag (%s TagFilter)", v.Name, v.Shape.Kind, v.Shape.Name, KindStruct)
+	}
+
+	if _, err := p.Input("CreateGadget"); err == nil {
+		t.Error("expected error for unknown operation")
+	}
+}
+
+func TestPackageOutput(t *testing.T) {
+	t.Parallel()
+
+	p, err := Load("testdata/widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err := p.Output("GetWidget")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.Fields), 1; got != want {
+		t.Fatalf("len(Fields) = %d, want %d", got, want)
+	}
+
+	field := output.Fields[0]
+	if got, want := field.Shape.Kind, KindStruct; got != want {
+		t.Errorf("Widget Kind = %s, want %s", got, want)
+	}
+
+	for name, want := range map[string]Kind{
+		"CreatedAt":     KindTimestamp,
+		"Configuration": KindStruct,
+		"Status":        KindEnum,
+		"WidgetId":      KindString,
+	} {
+		v, ok := field.Shape.Field(name)
+		if !ok {
+			t.Errorf("Widget.%s not found", name)
+			continue
+		}
+		if got := v.Shape.Kind; got != want {
+			t.Errorf("Widget.%s Kind = %s, want %s", name, got, want)
+		}
+	}
+
+	output, err = p.Output("DeleteWidget")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.Fields), 0; got != want {
+		t.Errorf("len(Fields) = %d, want %d", got, want)
+	}
+}